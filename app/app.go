@@ -20,6 +20,8 @@ import (
 	bam "github.com/irisnet/irishub/baseapp"
 	"github.com/irisnet/irishub/modules/arbitration"
 	"github.com/irisnet/irishub/modules/arbitration/params"
+	"github.com/irisnet/irishub/modules/asset"
+	"github.com/irisnet/irishub/modules/asset/params"
 	"github.com/irisnet/irishub/modules/gov"
 	"github.com/irisnet/irishub/modules/gov/params"
 	"github.com/irisnet/irishub/modules/record"
@@ -57,6 +59,7 @@ type IrisApp struct {
 	// keys to access the substores
 	keyMain          *sdk.KVStoreKey
 	keyAccount       *sdk.KVStoreKey
+	keyBank          *sdk.KVStoreKey
 	keyStake         *sdk.KVStoreKey
 	tkeyStake        *sdk.TransientStoreKey
 	keySlashing      *sdk.KVStoreKey
@@ -71,6 +74,8 @@ type IrisApp struct {
 	keyService       *sdk.KVStoreKey
 	keyGuardian      *sdk.KVStoreKey
 	keyRecord        *sdk.KVStoreKey
+	keyArbitration   *sdk.KVStoreKey
+	keyAsset         *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	accountMapper       auth.AccountKeeper
@@ -86,10 +91,13 @@ type IrisApp struct {
 	serviceKeeper       service.Keeper
 	guardianKeeper      guardian.Keeper
 	recordKeeper        record.Keeper
+	arbitrationKeeper   arbitration.Keeper
+	assetKeeper         asset.Keeper
 
 	// fee manager
 	feeManager bam.FeeManager
 	hookHub    HookHub // handle Hook callback of any version modules
+	protocols  []Protocol // the protocols of the supported versions, see registerProtocols
 }
 
 func NewIrisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptions ...func(*bam.BaseApp)) *IrisApp {
@@ -104,6 +112,7 @@ func NewIrisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		cdc:              cdc,
 		keyMain:          sdk.NewKVStoreKey("main"),
 		keyAccount:       sdk.NewKVStoreKey("acc"),
+		keyBank:          sdk.NewKVStoreKey("bank"),
 		keyStake:         sdk.NewKVStoreKey("stake"),
 		tkeyStake:        sdk.NewTransientStoreKey("transient_stake"),
 		keyMint:          sdk.NewKVStoreKey("mint"),
@@ -118,6 +127,8 @@ func NewIrisApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		keyUpgrade:       sdk.NewKVStoreKey("upgrade"),
 		keyService:       sdk.NewKVStoreKey("service"),
 		keyGuardian:      sdk.NewKVStoreKey("guardian"),
+		keyArbitration:   sdk.NewKVStoreKey("arbitration"),
+		keyAsset:         sdk.NewKVStoreKey("asset"),
 	}
 
 	var lastHeight int64
@@ -145,6 +156,8 @@ func MakeCodec() *codec.Codec {
 	upgrade.RegisterCodec(cdc)
 	service.RegisterCodec(cdc)
 	guardian.RegisterCodec(cdc)
+	arbitration.RegisterCodec(cdc)
+	asset.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -160,7 +173,11 @@ func (app *IrisApp) initKeeper() {
 	)
 
 	// add handlers
-	app.bankKeeper = bank.NewBaseKeeper(app.accountMapper)
+	app.bankKeeper = bank.NewBaseKeeper(
+		app.cdc,
+		app.keyBank,
+		app.accountMapper,
+	)
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(
 		app.cdc,
 		app.keyFeeCollection,
@@ -177,7 +194,7 @@ func (app *IrisApp) initKeeper() {
 	)
 	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint,
 		app.paramsKeeper.Subspace(mint.DefaultParamspace),
-		&stakeKeeper, app.feeCollectionKeeper,
+		&stakeKeeper, app.bankKeeper, app.feeCollectionKeeper,
 	)
 	app.distrKeeper = distr.NewKeeper(
 		app.cdc,
@@ -193,28 +210,47 @@ func (app *IrisApp) initKeeper() {
 		slashing.DefaultCodespace,
 	)
 
-	app.govKeeper = gov.NewKeeper(
-		app.cdc,
-		app.keyGov,
-		app.bankKeeper, &stakeKeeper,
-		gov.DefaultCodespace,
-	)
-
 	app.recordKeeper = record.NewKeeper(
 		app.cdc,
 		app.keyRecord,
 		record.DefaultCodespace,
 	)
+	app.guardianKeeper = guardian.NewKeeper(
+		app.cdc,
+		app.keyGuardian,
+		guardian.DefaultCodespace,
+	)
+
+	app.govKeeper = gov.NewKeeper(
+		app.cdc,
+		app.keyGov,
+		app.bankKeeper,
+		app.distrKeeper,
+		app.guardianKeeper,
+		&stakeKeeper,
+		gov.DefaultCodespace,
+	)
 	app.serviceKeeper = service.NewKeeper(
 		app.cdc,
 		app.keyService,
 		app.bankKeeper,
+		app.distrKeeper,
+		app.guardianKeeper,
 		service.DefaultCodespace,
 	)
-	app.guardianKeeper = guardian.NewKeeper(
+	app.arbitrationKeeper = arbitration.NewKeeper(
 		app.cdc,
-		app.keyGuardian,
-		guardian.DefaultCodespace,
+		app.keyArbitration,
+		app.serviceKeeper,
+		app.guardianKeeper,
+		arbitration.DefaultCodespace,
+	)
+	app.assetKeeper = asset.NewKeeper(
+		app.cdc,
+		app.keyAsset, app.keyParams,
+		app.bankKeeper,
+		app.distrKeeper,
+		asset.DefaultCodespace,
 	)
 	app.upgradeKeeper = upgrade.NewKeeper(
 		app.cdc,
@@ -232,8 +268,7 @@ func (app *IrisApp) mountStoreAndSetupBaseApp(lastHeight int64) {
 	app.feeManager = bam.NewFeeManager(app.paramsKeeper.Subspace("Fee"))
 
 	// initialize BaseApp
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyStake, app.keySlashing, app.keyGov, app.keyMint, app.keyDistr,
-		app.keyFeeCollection, app.keyParams, app.keyUpgrade, app.keyRecord, app.keyService, app.keyGuardian)
+	app.MountStoresIAVL(app.protocolStoreKeys()...)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountMapper, app.feeCollectionKeeper))
@@ -262,67 +297,76 @@ func (app *IrisApp) registerParams() {
 		params.NewTypeTable(
 			upgradeparams.CurrentUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
 			upgradeparams.ProposalAcceptHeightParameter.GetStoreKey(), int64(0),
-			upgradeparams.SwitchPeriodParameter.GetStoreKey(), int64(0),
+			upgradeparams.UpgradeConfigParameter.GetStoreKey(), upgradeparams.UpgradeConfig{},
+			upgradeparams.AbortUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
 		)),
 		&upgradeparams.CurrentUpgradeProposalIdParameter,
 		&upgradeparams.ProposalAcceptHeightParameter,
-		&upgradeparams.SwitchPeriodParameter)
+		&upgradeparams.UpgradeConfigParameter,
+		&upgradeparams.AbortUpgradeProposalIdParameter)
 
 	params.SetParamReadWriter(app.paramsKeeper.Subspace(params.GovParamspace).WithTypeTable(
 		params.NewTypeTable(
 			govparams.DepositProcedureParameter.GetStoreKey(), govparams.DepositProcedure{},
 			govparams.VotingProcedureParameter.GetStoreKey(), govparams.VotingProcedure{},
 			govparams.TallyingProcedureParameter.GetStoreKey(), govparams.TallyingProcedure{},
+			govparams.ProposalProceduresParameter.GetStoreKey(), govparams.ProposalProcedures{},
+			govparams.DepositPolicyParameter.GetStoreKey(), govparams.DepositPolicy{},
 			serviceparams.MaxRequestTimeoutParameter.GetStoreKey(), int64(0),
 			serviceparams.MinDepositMultipleParameter.GetStoreKey(), int64(0),
+			upgradeparams.SwitchPeriodParameter.GetStoreKey(), int64(0),
+			upgradeparams.SwitchThresholdParameter.GetStoreKey(), sdk.Dec{},
+			serviceparams.SlashFractionParameter.GetStoreKey(), sdk.Dec{},
 			arbitrationparams.ComplaintRetrospectParameter.GetStoreKey(), time.Duration(0),
 			arbitrationparams.ArbitrationTimelimitParameter.GetStoreKey(), time.Duration(0),
+			assetparams.IssueFeeParameter.GetStoreKey(), sdk.Coin{},
+			assetparams.MintFeeParameter.GetStoreKey(), sdk.Coin{},
+			bam.FeeExchangeRatesParameter.GetStoreKey(), bam.FeeExchangeRates{},
 		)),
 		&govparams.DepositProcedureParameter,
 		&govparams.VotingProcedureParameter,
 		&govparams.TallyingProcedureParameter,
+		&govparams.ProposalProceduresParameter,
+		&govparams.DepositPolicyParameter,
 		&serviceparams.MaxRequestTimeoutParameter,
 		&serviceparams.MinDepositMultipleParameter,
+		&upgradeparams.SwitchPeriodParameter,
+		&upgradeparams.SwitchThresholdParameter,
+		&serviceparams.SlashFractionParameter,
 		&arbitrationparams.ComplaintRetrospectParameter,
-		&arbitrationparams.ArbitrationTimelimitParameter)
+		&arbitrationparams.ArbitrationTimelimitParameter,
+		&assetparams.IssueFeeParameter,
+		&assetparams.MintFeeParameter,
+		&bam.FeeExchangeRatesParameter)
 
 	params.RegisterGovParamMapping(
 		&govparams.DepositProcedureParameter,
 		&govparams.VotingProcedureParameter,
 		&govparams.TallyingProcedureParameter,
+		&govparams.ProposalProceduresParameter,
+		&govparams.DepositPolicyParameter,
 		&serviceparams.MaxRequestTimeoutParameter,
-		&serviceparams.MinDepositMultipleParameter)
+		&serviceparams.MinDepositMultipleParameter,
+		&serviceparams.SlashFractionParameter,
+		&upgradeparams.SwitchPeriodParameter,
+		&upgradeparams.SwitchThresholdParameter,
+		&assetparams.IssueFeeParameter,
+		&assetparams.MintFeeParameter,
+		&bam.FeeExchangeRatesParameter)
 }
 
 func (app *IrisApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keyMain, false)
 }
 
-// application updates every end block
+// application updates every begin block, run by the protocol of the current version
 func (app *IrisApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
-
-	// distribute rewards from previous block
-	distr.BeginBlocker(ctx, req, app.distrKeeper)
-
-	// mint new tokens for this new block
-	mint.BeginBlocker(ctx, app.mintKeeper)
-
-	return abci.ResponseBeginBlock{
-		Tags: tags.ToKVPairs(),
-	}
+	return app.currentProtocol(ctx).BeginBlocker(ctx, req)
 }
 
-// application updates every end block
+// application updates every end block, run by the protocol of the current version
 func (app *IrisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	tags := gov.EndBlocker(ctx, app.govKeeper)
-	validatorUpdates := stake.EndBlocker(ctx, app.stakeKeeper)
-	tags = tags.AppendTags(upgrade.EndBlocker(ctx, app.upgradeKeeper))
-	tags = tags.AppendTags(service.EndBlocker(ctx, app.serviceKeeper))
-	return abci.ResponseEndBlock{
-		ValidatorUpdates: validatorUpdates,
-		Tags:             tags,
-	}
+	return app.currentProtocol(ctx).EndBlocker(ctx, req)
 }
 
 // custom logic for iris initialization
@@ -343,7 +387,7 @@ func (app *IrisApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	// load the accounts
 	for _, gacc := range genesisState.Accounts {
 		acc := gacc.ToAccount()
-		acc.AccountNumber = app.accountMapper.GetNextAccountNumber(ctx)
+		acc.SetAccountNumber(app.accountMapper.GetNextAccountNumber(ctx))
 		app.accountMapper.SetAccount(ctx, acc)
 	}
 
@@ -408,6 +452,7 @@ func (app *IrisApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	service.InitGenesis(ctx, genesisState.ServiceData)
 	arbitration.InitGenesis(ctx, genesisState.ArbitrationData)
 	guardian.InitGenesis(ctx, app.guardianKeeper, genesisState.GuardianData)
+	asset.InitGenesis(ctx, app.assetKeeper, genesisState.AssetData)
 
 	return abci.ResponseInitChain{
 		Validators: validators,
@@ -428,17 +473,7 @@ func (app *IrisApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 	app.accountMapper.IterateAccounts(ctx, appendAccount)
 	fileAccounts := []GenesisFileAccount{}
 	for _, acc := range accounts {
-		var coinsString []string
-		for _, coin := range acc.Coins {
-			coinsString = append(coinsString, coin.String())
-		}
-		fileAccounts = append(fileAccounts,
-			GenesisFileAccount{
-				Address:       acc.Address,
-				Coins:         coinsString,
-				Sequence:      acc.Sequence,
-				AccountNumber: acc.AccountNumber,
-			})
+		fileAccounts = append(fileAccounts, NewGenesisFileAccountI(acc))
 	}
 	genState := NewGenesisFileState(
 		fileAccounts,
@@ -452,6 +487,7 @@ func (app *IrisApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 		arbitration.ExportGenesis(ctx),
 		guardian.ExportGenesis(ctx, app.guardianKeeper),
 		slashing.ExportGenesis(ctx, app.slashingKeeper),
+		asset.ExportGenesis(ctx, app.assetKeeper),
 	)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/irisnet/irishub/modules/arbitration"
 	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/asset"
 )
 
 var (
@@ -55,12 +56,13 @@ type GenesisState struct {
 	ServiceData     service.GenesisState     `json:"service"`
 	ArbitrationData arbitration.GenesisState `json:"arbitration"`
 	GuardianData    guardian.GenesisState    `json:"guardian"`
+	AssetData       asset.GenesisState       `json:"asset"`
 	GenTxs          []json.RawMessage        `json:"gentxs"`
 }
 
 func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState, stakeData stake.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState, upgradeData upgrade.GenesisState, serviceData service.GenesisState,
-	arbitrationData arbitration.GenesisState, guardianData guardian.GenesisState, slashingData slashing.GenesisState) GenesisState {
+	arbitrationData arbitration.GenesisState, guardianData guardian.GenesisState, slashingData slashing.GenesisState, assetData asset.GenesisState) GenesisState {
 
 	return GenesisState{
 		Accounts:        accounts,
@@ -74,6 +76,7 @@ func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState, stak
 		ArbitrationData: arbitrationData,
 		GuardianData:    guardianData,
 		SlashingData:    slashingData,
+		AssetData:       assetData,
 	}
 }
 
@@ -83,6 +86,13 @@ type GenesisAccount struct {
 	Coins         sdk.Coins      `json:"coins"`
 	Sequence      int64          `json:"sequence_number"`
 	AccountNumber int64          `json:"account_number"`
+
+	// vesting account fields, a vesting account has a non-empty original vesting
+	OriginalVesting  sdk.Coins `json:"original_vesting"`
+	DelegatedFree    sdk.Coins `json:"delegated_free"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
+	StartTime        int64     `json:"start_time"`
+	EndTime          int64     `json:"end_time"`
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -95,22 +105,53 @@ func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
 }
 
 func NewGenesisAccountI(acc auth.Account) GenesisAccount {
-	return GenesisAccount{
+	gacc := GenesisAccount{
 		Address:       acc.GetAddress(),
 		Coins:         acc.GetCoins(),
 		AccountNumber: acc.GetAccountNumber(),
 		Sequence:      acc.GetSequence(),
 	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		gacc.OriginalVesting = vacc.GetOriginalVesting()
+		gacc.DelegatedFree = vacc.GetDelegatedFree()
+		gacc.DelegatedVesting = vacc.GetDelegatedVesting()
+		gacc.StartTime = vacc.GetStartTime()
+		gacc.EndTime = vacc.GetEndTime()
+	}
+	return gacc
 }
 
-// convert GenesisAccount to auth.BaseAccount
-func (ga *GenesisAccount) ToAccount() (acc *auth.BaseAccount) {
-	return &auth.BaseAccount{
+// convert GenesisAccount to auth.Account: a continuous vesting account if it has a
+// start time, a delayed vesting account if it only has an original vesting,
+// otherwise an auth.BaseAccount
+func (ga *GenesisAccount) ToAccount() auth.Account {
+	baseAcc := &auth.BaseAccount{
 		Address:       ga.Address,
 		Coins:         ga.Coins.Sort(),
 		AccountNumber: ga.AccountNumber,
 		Sequence:      ga.Sequence,
 	}
+
+	if ga.OriginalVesting.IsZero() {
+		return baseAcc
+	}
+	baseVestingAcc := &auth.BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  ga.OriginalVesting.Sort(),
+		DelegatedFree:    ga.DelegatedFree.Sort(),
+		DelegatedVesting: ga.DelegatedVesting.Sort(),
+		EndTime:          ga.EndTime,
+	}
+	if ga.StartTime != 0 {
+		return &auth.ContinuousVestingAccount{
+			BaseVestingAccount: baseVestingAcc,
+			StartTime:          ga.StartTime,
+		}
+	}
+	return &auth.DelayedVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+	}
 }
 
 // Create the core parameters for genesis initialization for iris
@@ -176,6 +217,14 @@ func IrisValidateGenesisState(genesisState GenesisState) (err error) {
 	if err != nil {
 		return
 	}
+	err = service.ValidateGenesis(genesisState.ServiceData)
+	if err != nil {
+		return
+	}
+	err = gov.ValidateGenesis(genesisState.GovData)
+	if err != nil {
+		return
+	}
 	// skip stakeData validation as genesis is created from txs
 	if len(genesisState.GenTxs) > 0 {
 		return nil
@@ -193,6 +242,18 @@ func validateGenesisStateAccounts(accs []GenesisAccount) (err error) {
 			return fmt.Errorf("Duplicate account in genesis state: Address %v", acc.Address)
 		}
 		addrMap[strAddr] = true
+
+		if acc.OriginalVesting.IsZero() {
+			continue
+		}
+		if err = auth.ValidateVestingSchedule(acc.StartTime, acc.EndTime); err != nil {
+			return fmt.Errorf("Invalid vesting account %v: %s", acc.Address, err)
+		}
+		// the original vesting is held by the account, either as coins or as delegations
+		holdings := acc.Coins.Plus(acc.DelegatedFree).Plus(acc.DelegatedVesting)
+		if !holdings.Minus(acc.OriginalVesting).IsNotNegative() {
+			return fmt.Errorf("Invalid vesting account %v: original vesting %s exceeds the coins of the account", acc.Address, acc.OriginalVesting)
+		}
 	}
 	return
 }
@@ -361,6 +422,28 @@ func normalizeNativeToken(coins []string) sdk.Coins {
 	return accountCoins
 }
 
+// normalize the optional coins of a vesting account, zero coins are left out
+func normalizeVestingCoins(coins []string) sdk.Coins {
+	if len(coins) == 0 {
+		return nil
+	}
+	var vestingCoins sdk.Coins
+	for _, coin := range normalizeNativeToken(coins) {
+		if !coin.IsZero() {
+			vestingCoins = append(vestingCoins, coin)
+		}
+	}
+	return vestingCoins.Sort()
+}
+
+func coinsToStrings(coins sdk.Coins) []string {
+	var coinsString []string
+	for _, coin := range coins {
+		coinsString = append(coinsString, coin.String())
+	}
+	return coinsString
+}
+
 func convertToGenesisState(genesisFileState GenesisFileState) GenesisState {
 	var genesisAccounts []GenesisAccount
 	for _, gacc := range genesisFileState.Accounts {
@@ -369,6 +452,12 @@ func convertToGenesisState(genesisFileState GenesisFileState) GenesisState {
 			Coins:         normalizeNativeToken(gacc.Coins),
 			AccountNumber: gacc.AccountNumber,
 			Sequence:      gacc.Sequence,
+
+			OriginalVesting:  normalizeVestingCoins(gacc.OriginalVesting),
+			DelegatedFree:    normalizeVestingCoins(gacc.DelegatedFree),
+			DelegatedVesting: normalizeVestingCoins(gacc.DelegatedVesting),
+			StartTime:        gacc.StartTime,
+			EndTime:          gacc.EndTime,
 		}
 		genesisAccounts = append(genesisAccounts, acc)
 	}
@@ -384,6 +473,7 @@ func convertToGenesisState(genesisFileState GenesisFileState) GenesisState {
 		ServiceData:     genesisFileState.ServiceData,
 		ArbitrationData: genesisFileState.ArbitrationData,
 		GuardianData:    genesisFileState.GuardianData,
+		AssetData:       genesisFileState.AssetData,
 		GenTxs:          genesisFileState.GenTxs,
 	}
 }
@@ -400,6 +490,7 @@ type GenesisFileState struct {
 	ServiceData     service.GenesisState     `json:"service"`
 	GuardianData    guardian.GenesisState    `json:"guardian"`
 	ArbitrationData arbitration.GenesisState `json:"arbitration"`
+	AssetData       asset.GenesisState       `json:"asset"`
 	GenTxs          []json.RawMessage        `json:"gentxs"`
 }
 
@@ -408,24 +499,42 @@ type GenesisFileAccount struct {
 	Coins         []string       `json:"coins"`
 	Sequence      int64          `json:"sequence_number"`
 	AccountNumber int64          `json:"account_number"`
+
+	// vesting account fields, start and end times are unix times in seconds
+	OriginalVesting  []string `json:"original_vesting,omitempty"`
+	DelegatedFree    []string `json:"delegated_free,omitempty"`
+	DelegatedVesting []string `json:"delegated_vesting,omitempty"`
+	StartTime        int64    `json:"start_time,omitempty"`
+	EndTime          int64    `json:"end_time,omitempty"`
 }
 
 func NewGenesisFileAccount(acc *auth.BaseAccount) GenesisFileAccount {
-	var coins []string
-	for _, coin := range acc.Coins {
-		coins = append(coins, coin.String())
-	}
 	return GenesisFileAccount{
 		Address:       acc.Address,
-		Coins:         coins,
+		Coins:         coinsToStrings(acc.Coins),
 		AccountNumber: acc.AccountNumber,
 		Sequence:      acc.Sequence,
 	}
 }
 
+// NewGenesisFileAccountI converts a GenesisAccount, vesting fields included
+func NewGenesisFileAccountI(acc GenesisAccount) GenesisFileAccount {
+	return GenesisFileAccount{
+		Address:          acc.Address,
+		Coins:            coinsToStrings(acc.Coins),
+		Sequence:         acc.Sequence,
+		AccountNumber:    acc.AccountNumber,
+		OriginalVesting:  coinsToStrings(acc.OriginalVesting),
+		DelegatedFree:    coinsToStrings(acc.DelegatedFree),
+		DelegatedVesting: coinsToStrings(acc.DelegatedVesting),
+		StartTime:        acc.StartTime,
+		EndTime:          acc.EndTime,
+	}
+}
+
 func NewGenesisFileState(accounts []GenesisFileAccount, authData auth.GenesisState, stakeData stake.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState, upgradeData upgrade.GenesisState, serviceData service.GenesisState,
-	arbitrationData arbitration.GenesisState, guardianData guardian.GenesisState, slashingData slashing.GenesisState) GenesisFileState {
+	arbitrationData arbitration.GenesisState, guardianData guardian.GenesisState, slashingData slashing.GenesisState, assetData asset.GenesisState) GenesisFileState {
 
 	return GenesisFileState{
 		Accounts:        accounts,
@@ -439,6 +548,7 @@ func NewGenesisFileState(accounts []GenesisFileAccount, authData auth.GenesisSta
 		ArbitrationData: arbitrationData,
 		GuardianData:    guardianData,
 		SlashingData:    slashingData,
+		AssetData:       assetData,
 	}
 }
 
@@ -455,6 +565,7 @@ func NewDefaultGenesisFileState() GenesisFileState {
 		GuardianData:    guardian.DefaultGenesisState(),
 		ArbitrationData: arbitration.DefaultGenesisState(),
 		SlashingData:    slashing.DefaultGenesisState(),
+		AssetData:       asset.DefaultGenesisState(),
 		GenTxs:          nil,
 	}
 }
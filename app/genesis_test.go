@@ -0,0 +1,80 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/modules/auth"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func newGenesisTestAccounts() []auth.Account {
+	coins := sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin(StakeDenom, 100)}
+	newBaseAccount := func() *auth.BaseAccount {
+		acc := auth.NewBaseAccountWithAddress(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+		acc.SetCoins(coins)
+		acc.SetAccountNumber(1)
+		acc.SetSequence(2)
+		return &acc
+	}
+
+	cva := auth.NewContinuousVestingAccount(newBaseAccount(), 1000, 2000)
+	cva.TrackDelegation(time.Unix(1500, 0), sdk.Coins{sdk.NewInt64Coin(StakeDenom, 70)})
+	dva := auth.NewDelayedVestingAccount(newBaseAccount(), 2000)
+	dva.TrackDelegation(time.Unix(1500, 0), sdk.Coins{sdk.NewInt64Coin(StakeDenom, 30)})
+	return []auth.Account{cva, dva, newBaseAccount()}
+}
+
+func requireAccountsEqual(t *testing.T, expected, actual auth.Account) {
+	require.IsType(t, expected, actual)
+	require.Equal(t, expected.GetAddress(), actual.GetAddress())
+	require.True(t, expected.GetCoins().IsEqual(actual.GetCoins()), actual.GetCoins().String())
+	require.Equal(t, expected.GetAccountNumber(), actual.GetAccountNumber())
+	require.Equal(t, expected.GetSequence(), actual.GetSequence())
+
+	vacc, ok := expected.(auth.VestingAccount)
+	if !ok {
+		return
+	}
+	actualVacc := actual.(auth.VestingAccount)
+	require.True(t, vacc.GetOriginalVesting().IsEqual(actualVacc.GetOriginalVesting()))
+	require.True(t, vacc.GetDelegatedFree().IsEqual(actualVacc.GetDelegatedFree()))
+	require.True(t, vacc.GetDelegatedVesting().IsEqual(actualVacc.GetDelegatedVesting()))
+	require.Equal(t, vacc.GetStartTime(), actualVacc.GetStartTime())
+	require.Equal(t, vacc.GetEndTime(), actualVacc.GetEndTime())
+}
+
+func TestGenesisAccount_ToAccount(t *testing.T) {
+	for _, acc := range newGenesisTestAccounts() {
+		gacc := NewGenesisAccountI(acc)
+		requireAccountsEqual(t, acc, gacc.ToAccount())
+	}
+
+	// an account without original vesting is a base account
+	gacc := GenesisAccount{
+		Address:   sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		Coins:     sdk.Coins{sdk.NewInt64Coin(StakeDenom, 100)},
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+	require.IsType(t, &auth.BaseAccount{}, gacc.ToAccount())
+}
+
+func TestGenesisAccount_ExportImport(t *testing.T) {
+	accs := newGenesisTestAccounts()
+
+	// the accounts go through the genesis file as an export followed by a new chain does
+	var fileAccs []GenesisFileAccount
+	for _, acc := range accs {
+		fileAccs = append(fileAccs, NewGenesisFileAccountI(NewGenesisAccountI(acc)))
+	}
+	require.Nil(t, fileAccs[2].OriginalVesting)
+
+	genesisState := convertToGenesisState(GenesisFileState{Accounts: fileAccs})
+	require.Equal(t, len(accs), len(genesisState.Accounts))
+	for i, gacc := range genesisState.Accounts {
+		requireAccountsEqual(t, accs[i], gacc.ToAccount())
+	}
+}
@@ -61,7 +61,13 @@ func (h HookHub) GetCurrentVersionHooks(ctx sdk.Context, trigger string) hooks {
 		panic("The stakeTrigger of the hookHub doesn't existed!")
 	}
 
-	return hookversion[version.Id]
+	// a version without hooks of its own keeps the hooks of the previous protocol
+	for id := version.Id; id >= 0; id-- {
+		if hks, ok := hookversion[id]; ok {
+			return hks
+		}
+	}
+	return nil
 }
 
 //______________________________________________________________________________________________
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/irisnet/irishub/modules/upgrade"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// MigrationDryRun - the result of running the migrations of a version against an exported state
+type MigrationDryRun struct {
+	Version    int64                     `json:"version"`
+	Migrations []upgrade.MigrationResult `json:"migrations"`
+	Error      string                    `json:"error,omitempty"`
+	Diffs      []StateDiff               `json:"diffs"`
+}
+
+// StateDiff - the exported state of a module before and after the migrations
+type StateDiff struct {
+	Module string          `json:"module"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// DryRunMigrations loads an exported state into the app, runs the migrations registered for a
+// version and reports the modules whose exported state they change. The app should be backed
+// by a throwaway db, nothing of the dry run is meant to be kept.
+func (app *IrisApp) DryRunMigrations(genDoc tmtypes.GenesisDoc, verId int64) (result MigrationDryRun, err error) {
+	result.Version = verId
+
+	// the validators of the state are checked by the stake genesis, the sanity check against the tendermint validators is skipped
+	app.InitChain(abci.RequestInitChain{ChainId: genDoc.ChainID, AppStateBytes: genDoc.AppState})
+	app.Commit()
+
+	before, _, err := app.ExportAppStateAndValidators()
+	if err != nil {
+		return result, err
+	}
+
+	ctx := app.NewContext(true, abci.Header{ChainID: genDoc.ChainID, Height: app.LastBlockHeight() + 1})
+	migrations, sdkErr := app.upgradeKeeper.RunMigrations(ctx, verId)
+	result.Migrations = migrations
+	if sdkErr != nil {
+		result.Error = sdkErr.Error()
+		return result, nil
+	}
+
+	after, _, err := app.ExportAppStateAndValidators()
+	if err != nil {
+		return result, err
+	}
+
+	result.Diffs, err = diffAppState(before, after)
+	return result, err
+}
+
+// diffAppState compares two exported app states module by module
+func diffAppState(before, after json.RawMessage) ([]StateDiff, error) {
+	var beforeModules, afterModules map[string]json.RawMessage
+	if err := json.Unmarshal(before, &beforeModules); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(after, &afterModules); err != nil {
+		return nil, err
+	}
+
+	modules := make(map[string]bool)
+	for module := range beforeModules {
+		modules[module] = true
+	}
+	for module := range afterModules {
+		modules[module] = true
+	}
+	var names []string
+	for module := range modules {
+		names = append(names, module)
+	}
+	sort.Strings(names)
+
+	diffs := []StateDiff{}
+	for _, module := range names {
+		equal, err := jsonEqual(beforeModules[module], afterModules[module])
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare the state of %s: %v", module, err)
+		}
+		if !equal {
+			diffs = append(diffs, StateDiff{Module: module, Before: beforeModules[module], After: afterModules[module]})
+		}
+	}
+	return diffs, nil
+}
+
+func jsonEqual(a, b json.RawMessage) (bool, error) {
+	if a == nil || b == nil {
+		return a == nil && b == nil, nil
+	}
+	var ca, cb bytes.Buffer
+	if err := json.Compact(&ca, a); err != nil {
+		return false, err
+	}
+	if err := json.Compact(&cb, b); err != nil {
+		return false, err
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes()), nil
+}
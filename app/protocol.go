@@ -1,9 +1,13 @@
 package app
 
 import (
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
+	"github.com/irisnet/irishub/modules/auth"
 	"github.com/irisnet/irishub/modules/bank"
 	distr "github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/mint"
 	"github.com/irisnet/irishub/modules/slashing"
 	"github.com/irisnet/irishub/modules/stake"
 	"github.com/irisnet/irishub/modules/gov"
@@ -11,46 +15,282 @@ import (
 	"github.com/irisnet/irishub/modules/service"
 	"github.com/irisnet/irishub/modules/upgrade"
 	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/arbitration"
+	"github.com/irisnet/irishub/modules/asset"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-const LastProtocolVersion = 0
+// Protocol - everything a version of the software runs. A software upgrade adds the protocol of
+// the new version to the registry, modules left out of it are retired at the switch.
+type Protocol struct {
+	Version      int64
+	StoreKeys    []*sdk.KVStoreKey // KV stores used by the version, all the versions' stores are mounted
+	Routes       []ProtocolRoute
+	QueryRoutes  []ProtocolQueryRoute
+	Hooks        []ProtocolHook
+	BeginBlocker sdk.BeginBlocker
+	EndBlocker   sdk.EndBlocker
+	Migrations   []upgrade.Migration // run once at the switch to the version, in order
+}
+
+// ProtocolRoute - a message route and the stores its handler can access
+type ProtocolRoute struct {
+	Name      string
+	StoreKeys []*sdk.KVStoreKey
+	Handler   sdk.Handler
+}
+
+type ProtocolQueryRoute struct {
+	Name    string
+	Querier sdk.Querier
+}
+
+type ProtocolHook struct {
+	Trigger string
+	Hook    Hook
+}
+
+// handler name of a route in the router, the routes of the later versions carry the version id
+func (p Protocol) handlerName(route string) string {
+	if p.Version == 0 {
+		return route
+	}
+	return fmt.Sprintf("%s-%d", route, p.Version)
+}
+
+func (p Protocol) querier(route string) sdk.Querier {
+	for _, queryRoute := range p.QueryRoutes {
+		if queryRoute.Name == route {
+			return queryRoute.Querier
+		}
+	}
+	return nil
+}
+
+func (p Protocol) moduleList() upgrade.ModuleLifeTimeList {
+	moduleList := upgrade.NewModuleLifeTimeList()
+	for _, route := range p.Routes {
+		var stores []string
+		for _, key := range route.StoreKeys {
+			stores = append(stores, key.Name())
+		}
+		moduleList = moduleList.BuildModuleLifeTime(0, p.handlerName(route.Name), stores)
+	}
+	return moduleList
+}
+
+// the protocols this software can run, in increasing versions. A bug fix upgrade that doesn't
+// change the modules needs no protocol of its own, it keeps running the protocol of the previous version.
+func (app *IrisApp) registerProtocols() {
+	app.protocols = []Protocol{
+		app.protocolV0(),
+		app.protocolV1(),
+		app.protocolV2(),
+		app.protocolV3(),
+	}
+	for i, p := range app.protocols {
+		if i == 0 && p.Version != 0 || i > 0 && p.Version <= app.protocols[i-1].Version {
+			panic(fmt.Sprintf("The protocol version %d is not in increasing order", p.Version))
+		}
+	}
+}
 
 func (app *IrisApp) wireRouterForAllVersion() {
-	for i := 0; i <= LastProtocolVersion; i++ {
-		app.wireRouterForVerion(i)
+	app.registerProtocols()
+
+	var queryRoutes []string
+	registered := make(map[string]bool)
+	for _, p := range app.protocols {
+		for _, route := range p.Routes {
+			app.Router().AddRoute(p.handlerName(route.Name), route.StoreKeys, route.Handler)
+		}
+		for _, hook := range p.Hooks {
+			app.hookHub.AddHook(hook.Trigger, p.Version, hook.Hook)
+		}
+		for _, queryRoute := range p.QueryRoutes {
+			if !registered[queryRoute.Name] {
+				registered[queryRoute.Name] = true
+				queryRoutes = append(queryRoutes, queryRoute.Name)
+			}
+		}
+		upgrade.RegisterVersionModuleList(p.Version, p.moduleList())
+		upgrade.RegisterMigrations(p.Version, p.Migrations)
+	}
+
+	// a query route is served by the querier of the current version
+	for _, route := range queryRoutes {
+		app.QueryRouter().AddRoute(route, app.versionedQuerier(route))
 	}
 }
 
-func (app *IrisApp) wireRouterForVerion(version int) {
-	if version > LastProtocolVersion {
-		panic("The protocal version is not valid!")
+// the KV stores of all the protocols
+func (app *IrisApp) protocolStoreKeys() []*sdk.KVStoreKey {
+	var keys []*sdk.KVStoreKey
+	mounted := make(map[string]bool)
+	for _, p := range app.protocols {
+		for _, key := range p.StoreKeys {
+			if !mounted[key.Name()] {
+				mounted[key.Name()] = true
+				keys = append(keys, key)
+			}
+		}
 	}
+	return keys
+}
+
+// the protocol of the version the chain runs
+func (app *IrisApp) currentProtocol(ctx sdk.Context) Protocol {
+	var versionID int64
+	if version := app.upgradeKeeper.GetCurrentVersion(ctx); version != nil {
+		versionID = version.Id
+	}
+	for i := len(app.protocols) - 1; i > 0; i-- {
+		if app.protocols[i].Version <= versionID {
+			return app.protocols[i]
+		}
+	}
+	return app.protocols[0]
+}
 
-	// register message routes
+func (app *IrisApp) versionedQuerier(route string) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		p := app.currentProtocol(ctx)
+		querier := p.querier(route)
+		if querier == nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("The query route %s is not available in protocol version %d", route, p.Version))
+		}
+		return querier(ctx, path, req)
+	}
+}
+
+//______________________________________________________________________________________________
+// protocol version 0
+
+func (app *IrisApp) protocolV0() Protocol {
 	// need to update each module's msg type
-	switch version {
-	case 0:
-		app.Router().
-			AddRoute("bank", []*sdk.KVStoreKey{app.keyAccount}, bank.NewHandler(app.bankKeeper)).
-			AddRoute("stake", []*sdk.KVStoreKey{app.keyStake, app.keyAccount, app.keyMint, app.keyDistr}, stake.NewHandler(app.stakeKeeper)).
-			AddRoute("slashing", []*sdk.KVStoreKey{app.keySlashing, app.keyStake}, slashing.NewHandler(app.slashingKeeper)).
-			AddRoute("distr", []*sdk.KVStoreKey{app.keyDistr}, distr.NewHandler(app.distrKeeper)).
-			AddRoute("gov", []*sdk.KVStoreKey{app.keyGov, app.keyAccount, app.keyStake, app.keyParams}, gov.NewHandler(app.govKeeper)).
-			AddRoute("upgrade", []*sdk.KVStoreKey{app.keyUpgrade, app.keyStake}, upgrade.NewHandler(app.upgradeKeeper)).
-			AddRoute("record", []*sdk.KVStoreKey{app.keyRecord}, record.NewHandler(app.recordKeeper)).
-			AddRoute("service", []*sdk.KVStoreKey{app.keyService}, service.NewHandler(app.serviceKeeper)).
-			AddRoute("guardian", []*sdk.KVStoreKey{app.keyGuardian}, guardian.NewHandler(app.guardianKeeper))
-
-		app.QueryRouter().
-			AddRoute("gov", gov.NewQuerier(app.govKeeper)).
-			AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc))
-
-		app.hookHub.
-			AddHook(stakeTrigger, 0, app.distrKeeper.Hooks()).
-			AddHook(stakeTrigger, 0, app.slashingKeeper.Hooks())
-
-		break
-	default:
-		panic("The protocal version is not valid!")
+	return Protocol{
+		Version: 0,
+		StoreKeys: []*sdk.KVStoreKey{app.keyMain, app.keyAccount, app.keyStake, app.keySlashing, app.keyGov, app.keyMint, app.keyDistr,
+			app.keyFeeCollection, app.keyParams, app.keyUpgrade, app.keyRecord, app.keyService, app.keyGuardian, app.keyArbitration},
+		Routes: []ProtocolRoute{
+			{"bank", []*sdk.KVStoreKey{app.keyAccount}, bank.NewHandler(app.bankKeeper)},
+			{"stake", []*sdk.KVStoreKey{app.keyStake, app.keyAccount, app.keyMint, app.keyDistr}, stake.NewHandler(app.stakeKeeper)},
+			{"slashing", []*sdk.KVStoreKey{app.keySlashing, app.keyStake}, slashing.NewHandler(app.slashingKeeper)},
+			{"distr", []*sdk.KVStoreKey{app.keyDistr}, distr.NewHandler(app.distrKeeper)},
+			{"gov", []*sdk.KVStoreKey{app.keyGov, app.keyAccount, app.keyStake, app.keyParams, app.keyDistr, app.keyGuardian}, gov.NewHandler(app.govKeeper)},
+			{"upgrade", []*sdk.KVStoreKey{app.keyUpgrade, app.keyStake}, upgrade.NewHandler(app.upgradeKeeper)},
+			{"record", []*sdk.KVStoreKey{app.keyRecord}, record.NewHandler(app.recordKeeper)},
+			{"service", []*sdk.KVStoreKey{app.keyService, app.keyGuardian}, service.NewHandler(app.serviceKeeper)},
+			{"guardian", []*sdk.KVStoreKey{app.keyGuardian}, guardian.NewHandler(app.guardianKeeper)},
+			{"arbitration", []*sdk.KVStoreKey{app.keyArbitration, app.keyService, app.keyGuardian}, arbitration.NewHandler(app.arbitrationKeeper)},
+		},
+		QueryRoutes: []ProtocolQueryRoute{
+			{"gov", gov.NewQuerier(app.govKeeper)},
+			{"stake", stake.NewQuerier(app.stakeKeeper, app.cdc)},
+			{"service", service.NewQuerier(app.serviceKeeper)},
+			{"record", record.NewQuerier(app.recordKeeper)},
+			{"guardian", guardian.NewQuerier(app.guardianKeeper)},
+			{"upgrade", upgrade.NewQuerier(app.upgradeKeeper)},
+		},
+		Hooks: []ProtocolHook{
+			{stakeTrigger, app.distrKeeper.Hooks()},
+			{stakeTrigger, app.slashingKeeper.Hooks()},
+		},
+		BeginBlocker: app.beginBlockerV0,
+		EndBlocker:   app.endBlockerV0,
+	}
+}
+
+//______________________________________________________________________________________________
+// protocol version 1
+
+// the modules of version 0, the records stored before the owner index existed are indexed at the switch
+func (app *IrisApp) protocolV1() Protocol {
+	p := app.protocolV0()
+	p.Version = 1
+	p.Migrations = []upgrade.Migration{
+		{Module: "record", Name: "index the records by owner", Migrate: app.recordKeeper.IndexOwnerRecords},
+	}
+	return p
+}
+
+//______________________________________________________________________________________________
+// protocol version 2
+
+// the modules of version 1 and the asset module
+func (app *IrisApp) protocolV2() Protocol {
+	p := app.protocolV1()
+	p.Version = 2
+	p.StoreKeys = append(p.StoreKeys, app.keyAsset)
+	p.Routes = append(p.Routes,
+		ProtocolRoute{"asset", []*sdk.KVStoreKey{app.keyAsset, app.keyAccount, app.keyParams, app.keyDistr}, asset.NewHandler(app.assetKeeper)})
+	p.QueryRoutes = append(p.QueryRoutes, ProtocolQueryRoute{"asset", asset.NewQuerier(app.assetKeeper)})
+	p.Migrations = nil
+	return p
+}
+
+//______________________________________________________________________________________________
+// protocol version 3
+
+// the modules of version 2 and the supply kept by the bank, the supply is initialized at the switch
+func (app *IrisApp) protocolV3() Protocol {
+	p := app.protocolV2()
+	p.Version = 3
+	p.StoreKeys = append(p.StoreKeys, app.keyBank)
+	for i, route := range p.Routes {
+		switch route.Name {
+		case "bank", "gov", "asset":
+			p.Routes[i].StoreKeys = append(route.StoreKeys, app.keyBank)
+		}
+	}
+	p.QueryRoutes = append(p.QueryRoutes, ProtocolQueryRoute{"bank", bank.NewQuerier(app.bankKeeper)})
+	p.Migrations = []upgrade.Migration{
+		{Module: "bank", Name: "initialize the supply", Migrate: app.initBankSupply},
+	}
+	return p
+}
+
+// the supply of the bond denom is the token supply of the stake pool, the other denoms are only held by the accounts
+func (app *IrisApp) initBankSupply(ctx sdk.Context) error {
+	bondDenom := app.stakeKeeper.BondDenom(ctx)
+	supply := sdk.Coins{}
+	app.accountMapper.IterateAccounts(ctx, func(acc auth.Account) bool {
+		for _, coin := range acc.GetCoins() {
+			if coin.Denom != bondDenom && coin.IsPositive() {
+				supply = supply.Plus(sdk.Coins{coin})
+			}
+		}
+		return false
+	})
+	if tokens := app.stakeKeeper.GetPool(ctx).TokenSupply().TruncateInt(); tokens.Sign() > 0 {
+		supply = supply.Plus(sdk.Coins{sdk.NewCoin(bondDenom, tokens)})
+	}
+	app.bankKeeper.InitSupply(ctx, supply)
+	return nil
+}
+
+func (app *IrisApp) beginBlockerV0(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
+
+	// distribute rewards from previous block
+	distr.BeginBlocker(ctx, req, app.distrKeeper)
+
+	// mint new tokens for this new block
+	mint.BeginBlocker(ctx, app.mintKeeper)
+
+	return abci.ResponseBeginBlock{
+		Tags: tags.ToKVPairs(),
+	}
+}
+
+func (app *IrisApp) endBlockerV0(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	tags := gov.EndBlocker(ctx, app.govKeeper)
+	validatorUpdates := stake.EndBlocker(ctx, app.stakeKeeper)
+	tags = tags.AppendTags(upgrade.EndBlocker(ctx, app.upgradeKeeper))
+	tags = tags.AppendTags(service.EndBlocker(ctx, app.serviceKeeper))
+	tags = tags.AppendTags(arbitration.EndBlocker(ctx, app.arbitrationKeeper))
+	return abci.ResponseEndBlock{
+		ValidatorUpdates: validatorUpdates,
+		Tags:             tags,
 	}
 }
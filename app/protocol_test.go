@@ -0,0 +1,131 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/irisnet/irishub/modules/stake"
+	"github.com/irisnet/irishub/modules/upgrade"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestApp() (*IrisApp, sdk.Context) {
+	app := NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	return app, app.NewContext(true, abci.Header{})
+}
+
+// switches the chain to the next version
+func switchVersion(app *IrisApp, ctx sdk.Context, proposalID uint64) {
+	app.upgradeKeeper.AddNewVersion(ctx, upgrade.NewVersion(0, proposalID, ctx.BlockHeight(), nil))
+}
+
+func stubQuerier(res string) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		return []byte(res), nil
+	}
+}
+
+func TestProtocol_VersionedRoutes(t *testing.T) {
+	app, _ := newTestApp()
+
+	routes := make(map[string]bool)
+	for _, route := range app.Router().RouteTable() {
+		routes[strings.Split(route, "/")[0]] = true
+	}
+	for _, p := range app.protocols {
+		for _, route := range p.Routes {
+			require.True(t, routes[p.handlerName(route.Name)], p.handlerName(route.Name))
+		}
+
+		// the module list of a version refers to the handlers of its protocol
+		require.Equal(t, p.handlerName("bank"), upgrade.GetModuleFromBucket(p.Version, "bank").Handler)
+	}
+	require.Equal(t, "bank", Protocol{Version: 0}.handlerName("bank"))
+	require.Equal(t, "bank-3", Protocol{Version: 3}.handlerName("bank"))
+}
+
+func TestProtocol_CurrentProtocol(t *testing.T) {
+	app, ctx := newTestApp()
+	last := app.protocols[len(app.protocols)-1].Version
+
+	// a future protocol serving its own bank queries and retiring the record queries
+	app.protocols = append(app.protocols, Protocol{
+		Version: last + 2,
+		QueryRoutes: []ProtocolQueryRoute{
+			{"bank", stubQuerier("bank-future")},
+		},
+	})
+
+	for version := int64(0); version <= last; version++ {
+		switchVersion(app, ctx, uint64(version))
+		require.Equal(t, version, app.currentProtocol(ctx).Version)
+	}
+
+	// a bug fix version without a protocol of its own runs the previous protocol
+	switchVersion(app, ctx, uint64(last+1))
+	require.Equal(t, last+1, app.upgradeKeeper.GetCurrentVersion(ctx).Id)
+	require.Equal(t, last, app.currentProtocol(ctx).Version)
+	require.NotNil(t, app.currentProtocol(ctx).querier("record"))
+
+	// the queries are served by the querier of the current protocol
+	switchVersion(app, ctx, uint64(last+2))
+	require.Equal(t, last+2, app.currentProtocol(ctx).Version)
+	res, err := app.versionedQuerier("bank")(ctx, []string{"supply"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Equal(t, []byte("bank-future"), res)
+
+	_, err = app.versionedQuerier("record")(ctx, []string{"record"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestHookHub_GetCurrentVersionHooks(t *testing.T) {
+	app, ctx := newTestApp()
+
+	hub := NewHooksHub(app.upgradeKeeper)
+	hub.AddHook(stakeTrigger, 0, "hook-0")
+	hub.AddHook(stakeTrigger, 2, "hook-2")
+
+	switchVersion(app, ctx, 0)
+	require.Equal(t, hooks{"hook-0"}, hub.GetCurrentVersionHooks(ctx, stakeTrigger))
+
+	// a version without hooks of its own keeps the hooks of the previous version
+	switchVersion(app, ctx, 1)
+	require.Equal(t, hooks{"hook-0"}, hub.GetCurrentVersionHooks(ctx, stakeTrigger))
+
+	switchVersion(app, ctx, 2)
+	require.Equal(t, hooks{"hook-2"}, hub.GetCurrentVersionHooks(ctx, stakeTrigger))
+	switchVersion(app, ctx, 3)
+	require.Equal(t, hooks{"hook-2"}, hub.GetCurrentVersionHooks(ctx, stakeTrigger))
+
+	require.Nil(t, hub.GetHooks(stakeTrigger, 1))
+	require.Panics(t, func() { hub.GetCurrentVersionHooks(ctx, "unknown") })
+}
+
+func TestProtocol_InitBankSupply(t *testing.T) {
+	app, ctx := newTestApp()
+	app.stakeKeeper.SetParams(ctx, stake.DefaultParams())
+	bondDenom := app.stakeKeeper.BondDenom(ctx)
+
+	pool := stake.InitialPool()
+	pool.LooseTokens = sdk.NewDecWithPrec(1005, 1)
+	pool.BondedTokens = sdk.NewDec(50)
+	app.stakeKeeper.SetPool(ctx, pool)
+
+	acc := app.accountMapper.NewAccountWithAddress(ctx, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	acc.SetCoins(sdk.Coins{sdk.NewInt64Coin("btc", 5), sdk.NewInt64Coin(bondDenom, 30)})
+	app.accountMapper.SetAccount(ctx, acc)
+
+	require.False(t, app.bankKeeper.IsSupplyTracked(ctx))
+	require.Nil(t, app.initBankSupply(ctx))
+	require.True(t, app.bankKeeper.IsSupplyTracked(ctx))
+
+	// the bond denom held by the accounts is part of the token supply of the stake pool
+	supply := sdk.Coins{sdk.NewInt64Coin("btc", 5)}.Plus(sdk.Coins{sdk.NewInt64Coin(bondDenom, 150)})
+	require.True(t, app.bankKeeper.GetTotalSupply(ctx).IsEqual(supply))
+}
@@ -13,8 +13,6 @@ import (
 var (
 	nativeFeeTokenKey          = []byte("feeTokenNative")
 	nativeGasPriceThresholdKey = []byte("feeTokenGasPriceThreshold")
-	//	FeeExchangeRatePrefix = "feeToken/exchangeRate/"	//  key = gov/feeToken/exchangeRate/<denomination>, rate = BigInt(value)/10^9
-	//	RatePrecision = int64(1000000000) //10^9
 )
 
 // NewFeePreprocessHandler creates a fee token preprocesser
@@ -24,8 +22,7 @@ func NewFeePreprocessHandler(fm FeeManager) types.FeePreprocessHandler {
 		if !ok {
 			return sdk.ErrInternal("tx must be StdTx")
 		}
-		totalNativeFee := fm.getNativeFeeToken(ctx, stdTx.Fee.Amount)
-		return fm.feePreprocess(ctx, sdk.Coins{totalNativeFee}, stdTx.Fee.Gas)
+		return fm.feePreprocess(ctx, stdTx.Fee.Amount, stdTx.Fee.Gas)
 	}
 }
 
@@ -54,18 +51,19 @@ func NewFeeRefundHandler(am auth.AccountKeeper, fck auth.FeeCollectionKeeper, fm
 		// It is not reasonable to consume users' gas. So the context gas is reset to transaction gas
 		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
-		totalNativeFee := fm.getNativeFeeToken(ctx, stdTx.Fee.Amount)
+		// the fee is refunded in the token it was paid in
+		totalFee := fm.getFeeToken(ctx, stdTx.Fee.Amount)
 
 		//If all gas has been consumed, then there is no necessary to run fee refund process
 		if txResult.GasWanted <= txResult.GasUsed {
-			actualCostFee = totalNativeFee
+			actualCostFee = totalFee
 			return actualCostFee, nil
 		}
 
 		unusedGas := txResult.GasWanted - txResult.GasUsed
 		refundCoin := sdk.Coin{
-			Denom:  totalNativeFee.Denom,
-			Amount: totalNativeFee.Amount.Mul(sdk.NewInt(unusedGas)).Div(sdk.NewInt(txResult.GasWanted)),
+			Denom:  totalFee.Denom,
+			Amount: totalFee.Amount.Mul(sdk.NewInt(unusedGas)).Div(sdk.NewInt(txResult.GasWanted)),
 		}
 		coins := am.GetAccount(ctx, firstAccount.GetAddress()).GetCoins() // consume gas
 		err = firstAccount.SetCoins(coins.Plus(sdk.Coins{refundCoin}))
@@ -77,8 +75,8 @@ func NewFeeRefundHandler(am auth.AccountKeeper, fck auth.FeeCollectionKeeper, fm
 		fck.RefundCollectedFees(ctx, sdk.Coins{refundCoin})
 
 		actualCostFee = sdk.Coin{
-			Denom:  totalNativeFee.Denom,
-			Amount: totalNativeFee.Amount.Sub(refundCoin.Amount),
+			Denom:  totalFee.Denom,
+			Amount: totalFee.Amount.Sub(refundCoin.Amount),
 		}
 		return actualCostFee, nil
 	}
@@ -124,6 +122,33 @@ func (fck FeeManager) getNativeFeeToken(ctx sdk.Context, coins sdk.Coins) sdk.Co
 	}
 }
 
+// getFeeToken returns the token the fee is paid in: the native fee token if the fee has it,
+// otherwise the first token with an exchange rate
+func (fck FeeManager) getFeeToken(ctx sdk.Context, coins sdk.Coins) sdk.Coin {
+	nativeFee := fck.getNativeFeeToken(ctx, coins)
+	if nativeFee.Denom != "" {
+		return nativeFee
+	}
+	if len(coins) == 0 {
+		return nativeFee
+	}
+
+	exchangeRates := GetFeeExchangeRates(ctx)
+	for _, coin := range coins {
+		if _, ok := exchangeRates.GetRate(coin.Denom); !ok {
+			continue
+		}
+		if coin.Amount.BigInt() == nil {
+			return sdk.Coin{
+				Denom:  coin.Denom,
+				Amount: sdk.ZeroInt(),
+			}
+		}
+		return coin
+	}
+	return nativeFee
+}
+
 func (fck FeeManager) feePreprocess(ctx sdk.Context, coins sdk.Coins, gasLimit int64) sdk.Error {
 	if gasLimit <= 0 {
 		return sdk.ErrInternal(fmt.Sprintf("gaslimit %d should be larger than 0", gasLimit))
@@ -139,31 +164,21 @@ func (fck FeeManager) feePreprocess(ctx sdk.Context, coins sdk.Coins, gasLimit i
 		panic(errors.New("failed to parse gas price from string"))
 	}
 
-	if len(coins) < 1 || coins[0].Denom != nativeFeeToken {
-		return sdk.ErrInvalidCoins(fmt.Sprintf("no native fee token, expected native token is %s", nativeFeeToken))
+	// the refund only gives back the token the fee is paid in, so the fee can't mix tokens
+	if len(coins) > 1 {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("the fee %s must be paid in a single token", coins))
+	}
+	feeToken := fck.getFeeToken(ctx, coins)
+	if feeToken.Denom == "" {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("no fee token, expected native token %s or a token with an exchange rate", nativeFeeToken))
 	}
-	/*
-		equivalentTotalFee := sdk.NewInt(0)
-		for _,coin := range coins {
-			if coin.Denom != nativeFeeToken {
-				exchangeRateKey := FeeExchangeRatePrefix + coin.Denom
-				rateString, err := fck.getter.GetString(ctx, exchangeRateKey)
-				if err != nil {
-					continue
-				}
-				rate, ok := sdk.NewIntFromString(rateString)
-				if !ok {
-					panic(errors.New("failed to parse rate from string"))
-				}
-				equivalentFee := rate.Mul(coin.Amount).Div(sdk.NewInt(RatePrecision))
-				equivalentTotalFee = equivalentTotalFee.Add(equivalentFee)
 
-			} else {
-				equivalentTotalFee = equivalentTotalFee.Add(coin.Amount)
-			}
-		}
-	*/
-	equivalentTotalFee := coins[0].Amount
+	// the fee in other tokens is worth its amount times the exchange rate in the native token
+	equivalentTotalFee := feeToken.Amount
+	if feeToken.Denom != nativeFeeToken {
+		rate, _ := GetFeeExchangeRates(ctx).GetRate(feeToken.Denom)
+		equivalentTotalFee = rate.MulInt(feeToken.Amount).TruncateInt()
+	}
 	gasPrice := equivalentTotalFee.Div(sdk.NewInt(gasLimit))
 	if gasPrice.LT(threshold) {
 		return sdk.ErrInsufficientCoins(fmt.Sprintf("equivalent gas price (%s%s) is less than threshold (%s%s)", gasPrice.String(), nativeFeeToken, threshold.String(), nativeFeeToken))
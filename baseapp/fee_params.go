@@ -0,0 +1,122 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// FeeExchangeRate - the native fee token amount one unit of Denom is worth when paying fees
+type FeeExchangeRate struct {
+	Denom string  `json:"denom"`
+	Rate  sdk.Dec `json:"rate"`
+}
+
+// FeeExchangeRates - the tokens other than the native fee token that fees may be paid in
+type FeeExchangeRates []FeeExchangeRate
+
+// GetRate returns the exchange rate of a denom
+func (rates FeeExchangeRates) GetRate(denom string) (sdk.Dec, bool) {
+	for _, rate := range rates {
+		if rate.Denom == denom {
+			return rate.Rate, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+var FeeExchangeRatesParameter FeeExchangeRatesParam
+
+var _ params.GovParameter = (*FeeExchangeRatesParam)(nil)
+
+// FeeExchangeRatesParam - the exchange rates of the tokens fees may be paid in
+type FeeExchangeRatesParam struct {
+	Value      FeeExchangeRates
+	paramSpace params.Subspace
+}
+
+func (param *FeeExchangeRatesParam) InitGenesis(genesisState interface{}) {
+	param.Value = genesisState.(FeeExchangeRates)
+}
+
+func (param *FeeExchangeRatesParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *FeeExchangeRatesParam) GetStoreKey() []byte {
+	return []byte("feeExchangeRates")
+}
+
+func (param *FeeExchangeRatesParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *FeeExchangeRatesParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+func (param *FeeExchangeRatesParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *FeeExchangeRatesParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *FeeExchangeRatesParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *FeeExchangeRatesParam) Valid(jsonStr string) sdk.Error {
+	var value FeeExchangeRates
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidFeeExchangeRate, fmt.Sprintf("Json is not valid"))
+	}
+	denoms := make(map[string]bool, len(value))
+	for _, rate := range value {
+		if len(rate.Denom) == 0 {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidFeeExchangeRate, fmt.Sprintf("Invalid FeeExchangeRate, denom should not be empty"))
+		}
+		if denoms[rate.Denom] {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidFeeExchangeRate, fmt.Sprintf("Duplicate FeeExchangeRate of %s", rate.Denom))
+		}
+		if rate.Rate.IsNil() || !rate.Rate.GT(sdk.ZeroDec()) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidFeeExchangeRate, fmt.Sprintf("Invalid FeeExchangeRate of %s, rate should be positive", rate.Denom))
+		}
+		denoms[rate.Denom] = true
+	}
+	return nil
+}
+
+func GetFeeExchangeRates(ctx sdk.Context) FeeExchangeRates {
+	if !FeeExchangeRatesParameter.LoadValue(ctx) {
+		return nil
+	}
+	return FeeExchangeRatesParameter.Value
+}
+
+func SetFeeExchangeRates(ctx sdk.Context, rates FeeExchangeRates) {
+	FeeExchangeRatesParameter.Value = rates
+	FeeExchangeRatesParameter.SaveValue(ctx)
+}
@@ -0,0 +1,119 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/auth"
+	"github.com/irisnet/irishub/modules/params"
+	"github.com/irisnet/irishub/store"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// the native fee token is iris-atto with a gas price threshold of 20, a unit of abc is worth 1.5 iris-atto
+func createFeeTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, auth.FeeCollectionKeeper, FeeManager) {
+	keyAcc := sdk.NewKVStoreKey("acc")
+	keyFee := sdk.NewKVStoreKey("fee")
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyFee, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	am := auth.NewAccountKeeper(cdc, keyAcc, auth.ProtoBaseAccount)
+	fck := auth.NewFeeCollectionKeeper(cdc, keyFee)
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	fm := NewFeeManager(paramsKeeper.Subspace("Fee"))
+	InitGenesis(ctx, fm, FeeGenesisStateConfig{FeeTokenNative: "iris-atto", GasPriceThreshold: 20})
+
+	FeeExchangeRatesParameter.SetReadWriter(paramsKeeper.Subspace("Gov").WithTypeTable(params.NewTypeTable(
+		FeeExchangeRatesParameter.GetStoreKey(), FeeExchangeRates{},
+	)))
+	SetFeeExchangeRates(ctx, FeeExchangeRates{{Denom: "abc", Rate: sdk.NewDecWithPrec(15, 1)}})
+	return ctx, am, fck, fm
+}
+
+func newFeeTx(gas int64, amount ...sdk.Coin) auth.StdTx {
+	return auth.StdTx{Fee: auth.NewStdFee(gas, amount...)}
+}
+
+func TestFeePreprocessHandler(t *testing.T) {
+	ctx, _, _, fm := createFeeTestInput(t)
+	handler := NewFeePreprocessHandler(fm)
+
+	requireCode := func(code sdk.CodeType, err error) {
+		require.NotNil(t, err)
+		require.Equal(t, code, err.(sdk.Error).Code(), err.Error())
+	}
+
+	// the native fee token
+	require.Nil(t, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("iris-atto", 200000))))
+	requireCode(sdk.CodeInsufficientCoins, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("iris-atto", 199999))))
+
+	// another token is converted at its exchange rate, rounded down
+	require.Nil(t, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("abc", 133334))))
+	requireCode(sdk.CodeInsufficientCoins, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("abc", 133333))))
+
+	// a token without exchange rate can't pay fees
+	requireCode(sdk.CodeInvalidCoins, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("xyz", 1000000000))))
+	requireCode(sdk.CodeInvalidCoins, handler(ctx, newFeeTx(10000)))
+
+	// a fee mixing tokens is rejected, only one of them could be refunded
+	requireCode(sdk.CodeInvalidCoins, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("abc", 133334), sdk.NewInt64Coin("iris-atto", 200000))))
+
+	requireCode(sdk.CodeInternal, handler(ctx, newFeeTx(0, sdk.NewInt64Coin("iris-atto", 200000))))
+	requireCode(sdk.CodeInternal, handler(ctx, nil))
+
+	// the exchange rates are changed by governance
+	SetFeeExchangeRates(ctx, FeeExchangeRates{{Denom: "abc", Rate: sdk.NewDec(2)}})
+	require.Nil(t, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("abc", 100000))))
+	SetFeeExchangeRates(ctx, FeeExchangeRates{{Denom: "xyz", Rate: sdk.NewDec(2)}})
+	requireCode(sdk.CodeInvalidCoins, handler(ctx, newFeeTx(10000, sdk.NewInt64Coin("abc", 100000))))
+}
+
+func TestFeeRefundHandler(t *testing.T) {
+	ctx, am, fck, fm := createFeeTestInput(t)
+	handler := NewFeeRefundHandler(am, fck, fm)
+
+	// the fee of 200abc is already paid
+	acc := am.NewAccountWithAddress(ctx, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	require.Nil(t, acc.SetCoins(sdk.Coins{sdk.NewInt64Coin("abc", 50)}))
+	am.SetAccount(ctx, acc)
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("abc", 200)})
+	ctx = auth.WithSigners(ctx, []auth.Account{acc})
+	tx := newFeeTx(10000, sdk.NewInt64Coin("abc", 200))
+
+	// the unused fee is refunded in the token it was paid in
+	actualCostFee, err := handler(ctx, tx, sdk.Result{GasWanted: 10000, GasUsed: 2500})
+	require.Nil(t, err)
+	require.True(t, actualCostFee.IsEqual(sdk.NewInt64Coin("abc", 50)), actualCostFee.String())
+	require.True(t, am.GetAccount(ctx, acc.GetAddress()).GetCoins().IsEqual(sdk.Coins{sdk.NewInt64Coin("abc", 200)}))
+	require.True(t, fck.GetCollectedFees(ctx).IsEqual(sdk.Coins{sdk.NewInt64Coin("abc", 50)}))
+
+	// nothing is refunded when all the gas is used
+	actualCostFee, err = handler(ctx, tx, sdk.Result{GasWanted: 10000, GasUsed: 10000})
+	require.Nil(t, err)
+	require.True(t, actualCostFee.IsEqual(sdk.NewInt64Coin("abc", 200)), actualCostFee.String())
+	require.True(t, am.GetAccount(ctx, acc.GetAddress()).GetCoins().IsEqual(sdk.Coins{sdk.NewInt64Coin("abc", 200)}))
+
+	// a tx failing in the ante handler has no signers and no refund
+	actualCostFee, err = handler(auth.WithSigners(ctx, nil), tx, sdk.Result{GasWanted: 10000, GasUsed: 2500})
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coin{}, actualCostFee)
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagDefChainID  = "def-chain-id"
+	FlagServiceName = "service-name"
+	FlagBindChainID = "bind-chain-id"
+	FlagReqChainId  = "request-chain-id"
+	FlagReqId       = "request-id"
+	FlagReason      = "reason"
+	FlagRefund      = "refund"
+)
+
+var (
+	FsDefChainID  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServiceName = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBindChainID = flag.NewFlagSet("", flag.ContinueOnError)
+	FsReqChainId  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsReqId       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsReason      = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRefund      = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsDefChainID.String(FlagDefChainID, "", "the ID of the blockchain defined of the service")
+	FsServiceName.String(FlagServiceName, "", "service name")
+	FsBindChainID.String(FlagBindChainID, "", "the ID of the blockchain bond of the service")
+	FsReqChainId.String(FlagReqChainId, "", "the ID of the blockchain that the service invocation initiated")
+	FsReqId.String(FlagReqId, "", "the ID of the service invocation")
+	FsReason.String(FlagReason, "", "reason of the complaint or the ruling")
+	FsRefund.Bool(FlagRefund, false, "rule in favor of the consumer and refund the service fee, default false")
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	authcmd "github.com/irisnet/irishub/client/auth/cli"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/arbitration"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetCmdQueryComplaint(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "complaint",
+		Short:   "Query a complaint",
+		Example: "iriscli arbitration complaint --request-chain-id=<req-chain-id> --request-id=<request-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			reqChainID := viper.GetString(FlagReqChainId)
+			reqID := viper.GetString(FlagReqId)
+
+			res, err := cliCtx.QueryStore(arbitration.GetComplaintKey(reqChainID, reqID), storeName)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("complaint for request [%s] in %s is not existed", reqID, reqChainID)
+			}
+
+			var complaint arbitration.Complaint
+			cdc.MustUnmarshalBinaryLengthPrefixed(res, &complaint)
+			output, err := codec.MarshalJSONIndent(cdc, complaint)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsReqChainId)
+	cmd.Flags().AddFlagSet(FsReqId)
+
+	return cmd
+}
+
+func GetCmdQueryComplaints(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "complaints",
+		Short:   "Query for all complaints",
+		Example: "iriscli arbitration complaints",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			res, err := cliCtx.QuerySubspace(arbitration.GetComplaintsSubspaceKey(), storeName)
+			if err != nil {
+				return err
+			}
+
+			var complaints []arbitration.Complaint
+			for _, re := range res {
+				var complaint arbitration.Complaint
+				cdc.MustUnmarshalBinaryLengthPrefixed(re.Value, &complaint)
+				complaints = append(complaints, complaint)
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, complaints)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"os"
+
+	authcmd "github.com/irisnet/irishub/client/auth/cli"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/arbitration"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetCmdComplain(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complain",
+		Short: "Complain against a service response",
+		Example: "iriscli arbitration complain --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--def-chain-id=<def-chain-id> --service-name=<service name> --bind-chain-id=<bind-chain-id> " +
+			"--request-chain-id=<req-chain-id> --request-id=<request-id> --reason=<reason>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			defChainID := viper.GetString(FlagDefChainID)
+			name := viper.GetString(FlagServiceName)
+			bindChainID := viper.GetString(FlagBindChainID)
+			reqChainID := viper.GetString(FlagReqChainId)
+			reqID := viper.GetString(FlagReqId)
+			reason := viper.GetString(FlagReason)
+
+			msg := arbitration.NewMsgComplain(defChainID, name, bindChainID, reqChainID, reqID, fromAddr, reason)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsDefChainID)
+	cmd.Flags().AddFlagSet(FsServiceName)
+	cmd.Flags().AddFlagSet(FsBindChainID)
+	cmd.Flags().AddFlagSet(FsReqChainId)
+	cmd.Flags().AddFlagSet(FsReqId)
+	cmd.Flags().AddFlagSet(FsReason)
+
+	return cmd
+}
+
+func GetCmdArbitrate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arbitrate",
+		Short: "Rule on a pending complaint",
+		Example: "iriscli arbitration arbitrate --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--request-chain-id=<req-chain-id> --request-id=<request-id> --refund=true --reason=<ruling>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			reqChainID := viper.GetString(FlagReqChainId)
+			reqID := viper.GetString(FlagReqId)
+			refund := viper.GetBool(FlagRefund)
+			ruling := viper.GetString(FlagReason)
+
+			msg := arbitration.NewMsgArbitrate(reqChainID, reqID, fromAddr, refund, ruling)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsReqChainId)
+	cmd.Flags().AddFlagSet(FsReqId)
+	cmd.Flags().AddFlagSet(FsRefund)
+	cmd.Flags().AddFlagSet(FsReason)
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagSymbol        = "symbol"
+	FlagName          = "name"
+	FlagDecimal       = "decimal"
+	FlagInitialSupply = "initial-supply"
+	FlagMaxSupply     = "max-supply"
+	FlagAmount        = "amount"
+	FlagRecipient     = "recipient"
+	FlagNewOwner      = "new-owner"
+)
+
+var (
+	FsSymbol    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsIssue     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAmount    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRecipient = flag.NewFlagSet("", flag.ContinueOnError)
+	FsNewOwner  = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsSymbol.String(FlagSymbol, "", "symbol of the asset, 3 to 8 lowercase letters")
+	FsIssue.String(FlagName, "", "name of the asset")
+	FsIssue.Uint8(FlagDecimal, 0, "decimal of the asset, a multiple of 3 not greater than 18")
+	FsIssue.String(FlagInitialSupply, "0", "initial supply of the asset in its main unit, given to the owner")
+	FsIssue.String(FlagMaxSupply, "0", "max supply of the asset in its main unit, 0 for an unlimited supply")
+	FsAmount.String(FlagAmount, "", "amount of the asset, e.g. 10abc or 10000abc-milli")
+	FsRecipient.String(FlagRecipient, "", "bech32 encoded account receiving the minted asset, the owner by default")
+	FsNewOwner.String(FlagNewOwner, "", "bech32 encoded account of the new owner")
+}
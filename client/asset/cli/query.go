@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/asset"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetCmdQueryAsset(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-asset",
+		Short:   "Query an asset",
+		Example: "iriscli asset query-asset --symbol=abc",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := asset.QueryAssetParams{
+				Symbol: viper.GetString(FlagSymbol),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, asset.QueryAsset), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSymbol)
+	cmd.MarkFlagRequired(FlagSymbol)
+	return cmd
+}
+
+func GetCmdQueryAssets(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-assets",
+		Short:   "Query for all assets",
+		Example: "iriscli asset query-assets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, asset.QueryAssets), nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	authcmd "github.com/irisnet/irishub/client/auth/cli"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/asset"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetCmdIssueAsset(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Issue a new asset, the issue fee goes to the community pool",
+		Example: "iriscli asset issue --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--symbol=abc --name=\"ABC token\" --decimal=6 --initial-supply=1000000 --max-supply=10000000",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			decimal := uint8(viper.GetInt(FlagDecimal))
+			initialSupply, err := parseSupply(viper.GetString(FlagInitialSupply), decimal)
+			if err != nil {
+				return err
+			}
+			maxSupply, err := parseSupply(viper.GetString(FlagMaxSupply), decimal)
+			if err != nil {
+				return err
+			}
+			msg := asset.NewMsgIssueAsset(fromAddr, viper.GetString(FlagSymbol), viper.GetString(FlagName), decimal, initialSupply, maxSupply)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSymbol)
+	cmd.Flags().AddFlagSet(FsIssue)
+	cmd.MarkFlagRequired(FlagSymbol)
+	cmd.MarkFlagRequired(FlagName)
+	return cmd
+}
+
+func GetCmdMintAsset(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Mint more of an asset, only the owner is allowed",
+		Example: "iriscli asset mint --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--amount=100abc --recipient=<recipient address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			symbol, amount, err := parseAssetAmount(cliCtx, viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+			recipient := fromAddr
+			if recipientStr := viper.GetString(FlagRecipient); len(recipientStr) != 0 {
+				recipient, err = sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+			}
+			msg := asset.NewMsgMintAsset(fromAddr, symbol, amount, recipient)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(FsRecipient)
+	cmd.MarkFlagRequired(FlagAmount)
+	return cmd
+}
+
+func GetCmdBurnAsset(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn",
+		Short: "Burn an amount of an asset held by the owner, only the owner is allowed",
+		Example: "iriscli asset burn --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--amount=100abc",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			symbol, amount, err := parseAssetAmount(cliCtx, viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+			msg := asset.NewMsgBurnAsset(fromAddr, symbol, amount)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.MarkFlagRequired(FlagAmount)
+	return cmd
+}
+
+func GetCmdTransferAssetOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership",
+		Short: "Hand an asset over to a new owner, only the owner is allowed",
+		Example: "iriscli asset transfer-ownership --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--symbol=abc --new-owner=<new owner address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			newOwnerStr := viper.GetString(FlagNewOwner)
+			if len(newOwnerStr) == 0 {
+				return fmt.Errorf("must use --new-owner flag")
+			}
+			newOwner, err := sdk.AccAddressFromBech32(newOwnerStr)
+			if err != nil {
+				return err
+			}
+			msg := asset.NewMsgTransferAssetOwnership(fromAddr, viper.GetString(FlagSymbol), newOwner)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSymbol)
+	cmd.Flags().AddFlagSet(FsNewOwner)
+	cmd.MarkFlagRequired(FlagSymbol)
+	return cmd
+}
+
+func GetCmdFreezeAssetMint(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-mint",
+		Short: "Freeze the minting of an asset for good, only the owner is allowed",
+		Example: "iriscli asset freeze-mint --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--symbol=abc",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			msg := asset.NewMsgFreezeAssetMint(fromAddr, viper.GetString(FlagSymbol))
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSymbol)
+	cmd.MarkFlagRequired(FlagSymbol)
+	return cmd
+}
+
+// a supply in the main unit of an asset to its min unit
+func parseSupply(supplyStr string, decimal uint8) (sdk.Int, error) {
+	supply, ok := sdk.NewIntFromString(supplyStr)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid supply %s, should be an integer", supplyStr)
+	}
+	return supply.Mul(sdk.NewIntWithDecimal(1, int(decimal))), nil
+}
+
+// an amount of an asset in any of its units to its symbol and the amount in the min unit
+func parseAssetAmount(cliCtx context.CLIContext, amountStr string) (string, sdk.Int, error) {
+	coin, err := cliCtx.ParseCoin(amountStr)
+	if err != nil {
+		return "", sdk.Int{}, err
+	}
+	symbol, err := sdk.GetCoinName(amountStr)
+	if err != nil {
+		return "", sdk.Int{}, err
+	}
+	return symbol, coin.Amount, nil
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	authcmd "github.com/irisnet/irishub/client/auth/cli"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/modules/bank"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// BurnTxCmd will create a burn tx and sign it with the given key.
+func BurnTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "burn",
+		Short:   "Create and sign a tx burning coins of the sender",
+		Example: "iriscli bank burn --from <key name> --fee=0.004iris --chain-id=<chain-id> --amount=10iris",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			// parse coins trying to be burned
+			amount := viper.GetString(flagAmount)
+			coins, err := cliCtx.ParseCoins(amount)
+			if err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			account, err := cliCtx.GetAccount(from)
+			if err != nil {
+				return err
+			}
+
+			// ensure account has enough coins
+			if !account.GetCoins().IsAllGTE(coins) {
+				return fmt.Errorf("Address %s doesn't have enough coins to burn.", from)
+			}
+
+			msg := bank.NewMsgBurn(from, coins)
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txCtx, cliCtx, []sdk.Msg{msg}, false)
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Amount of coins to burn, for instance: 10iris")
+	cmd.MarkFlagRequired(flagAmount)
+
+	return cmd
+}
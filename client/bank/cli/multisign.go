@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/keys"
+	"github.com/irisnet/irishub/modules/auth"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
+// GetMultiSignCommand returns the multisign command
+func GetMultiSignCommand(codec *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign <file> <multisig key name> <signed file>...",
+		Short: "Combine the signatures of a multisig account",
+		Long: `Read a transaction from <file> and the signatures of the keys of a multisig
+account from the transactions signed with "iriscli bank sign --multisig", then
+combine them into the signature of the multisig account and print the signed
+transaction. The multisig key must be in the key store, see "iriscli keys add --multisig".`,
+		Example: "iriscli bank multisign <file> <multisig key name> <signed file 1> <signed file 2> --chain-id=<chain-id>",
+		RunE:    makeMultiSignCmd(codec),
+		Args:    cobra.MinimumNArgs(3),
+	}
+	cmd.Flags().Bool(flagAppend, true, "Append the signature to the existing ones. If disabled, old signatures would be overwritten")
+	return cmd
+}
+
+func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		stdTx, err := readAndUnmarshalStdTx(cdc, args[0])
+		if err != nil {
+			return
+		}
+
+		keybase, err := keys.GetKeyBase()
+		if err != nil {
+			return
+		}
+		info, err := keybase.Get(args[1])
+		if err != nil {
+			return
+		}
+		multisigPub, ok := info.GetPubKey().(multisig.PubKeyMultisigThreshold)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", args[1])
+		}
+		multisigAddr := sdk.AccAddress(multisigPub.Address())
+		if !isSigner(multisigAddr, stdTx.GetSigners()) {
+			return fmt.Errorf("the transaction's signers don't include %s", multisigAddr)
+		}
+
+		txCtx := context.NewTxContextFromCLI()
+		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		var accountNumber, sequence int64
+		signed := 0
+		for _, filename := range args[2:] {
+			signedTx, err := readAndUnmarshalStdTx(cdc, filename)
+			if err != nil {
+				return err
+			}
+			for _, sig := range signedTx.GetSignatures() {
+				if signed == 0 {
+					accountNumber, sequence = sig.AccountNumber, sig.Sequence
+				} else if sig.AccountNumber != accountNumber || sig.Sequence != sequence {
+					return fmt.Errorf("the signature of %s has a different account number or sequence", filename)
+				}
+
+				signBytes := auth.StdSignBytes(txCtx.ChainID, sig.AccountNumber, sig.Sequence, stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo())
+				if sig.PubKey == nil || !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+					return fmt.Errorf("a signature of %s doesn't sign the transaction", filename)
+				}
+				if err := multisigSig.AddSignatureFromPubKey(sig.Signature, sig.PubKey, multisigPub.PubKeys); err != nil {
+					return err
+				}
+				signed++
+			}
+		}
+		if signed < int(multisigPub.K) {
+			return fmt.Errorf("%d signatures are required, got %d", multisigPub.K, signed)
+		}
+
+		newSig := auth.StdSignature{
+			PubKey:        multisigPub,
+			Signature:     cdc.MustMarshalBinaryBare(multisigSig),
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+		}
+		sigs := stdTx.GetSignatures()
+		if len(sigs) == 0 || !viper.GetBool(flagAppend) {
+			sigs = []auth.StdSignature{newSig}
+		} else {
+			sigs = append(sigs, newSig)
+		}
+		newTx := auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		var json []byte
+		if cliCtx.Indent {
+			json, err = cdc.MarshalJSONIndent(newTx, "", "  ")
+		} else {
+			json, err = cdc.MarshalJSON(newTx)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", json)
+		return
+	}
+}
+
+func isSigner(addr sdk.AccAddress, signers []sdk.AccAddress) bool {
+	for _, signer := range signers {
+		if bytes.Equal(addr.Bytes(), signer.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/irisnet/irishub/client/context"
 	"github.com/spf13/cobra"
 	"github.com/irisnet/irishub/client/bank"
+	bankmodule "github.com/irisnet/irishub/modules/bank"
 )
 
 // GetAccountCmd returns a query account that will display the state of the
@@ -82,3 +83,32 @@ func GetCmdQueryCoinType(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySupply performs the supply query of a denom, or of all denoms
+func GetCmdQuerySupply(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply [denom]",
+		Short:   "Query the total supply of a denom, or of all denoms",
+		Example: "iriscli bank supply iris-atto",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := bankmodule.QuerySupplyParams{}
+			if len(args) > 0 {
+				params.Denom = args[0]
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, bankmodule.QuerySupply), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -19,6 +19,7 @@ const (
 	flagAppend    = "append"
 	flagPrintSigs = "print-sigs"
 	flagOffline   = "offline"
+	flagMultisig  = "multisig"
 )
 
 // GetSignCommand returns the sign command
@@ -31,7 +32,11 @@ Read a transaction from <file>, sign it, and print its JSON encoding.
 
 The --offline flag makes sure that the client will not reach out to the local cache.
 Thus account number or sequence number lookups will not be performed and it is
-recommended to set such parameters manually.`,
+recommended to set such parameters manually.
+
+The --multisig=<multisig address> flag signs on behalf of a multisig account the
+key is part of. The signed transaction only holds the new signature, the signatures
+of the keys are then combined with "iriscli bank multisign".`,
 		Example: "iriscli bank sign <file> --name <key name> --chain-id=<chain-id>",
 		RunE: makeSignCmd(codec, decoder),
 		Args: cobra.ExactArgs(1),
@@ -40,6 +45,7 @@ recommended to set such parameters manually.`,
 	cmd.Flags().Bool(flagAppend, true, "Append the signature to the existing ones. If disabled, old signatures would be overwritten")
 	cmd.Flags().Bool(flagPrintSigs, false, "Print the addresses that must sign the transaction and those who have already signed it, then exit")
 	cmd.Flags().Bool(flagOffline, false, "Offline mode. Do not query local cache.")
+	cmd.Flags().String(flagMultisig, "", "Address of the multisig account on behalf of which the transaction is signed")
 	return cmd
 }
 
@@ -59,9 +65,21 @@ func makeSignCmd(cdc *amino.Codec, decoder auth.AccountDecoder) func(cmd *cobra.
 		cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(decoder)
 		txCtx := context.NewTxContextFromCLI()
 
-		newTx, err := utils.SignStdTx(txCtx, cliCtx, name, stdTx, viper.GetBool(flagAppend), viper.GetBool(flagOffline))
-		if err != nil {
-			return err
+		var newTx auth.StdTx
+		if multisigAddrStr := viper.GetString(flagMultisig); multisigAddrStr != "" {
+			multisigAddr, err := sdk.AccAddressFromBech32(multisigAddrStr)
+			if err != nil {
+				return err
+			}
+			newTx, err = utils.SignStdTxWithSignerAddress(txCtx, cliCtx, multisigAddr, name, stdTx, viper.GetBool(flagOffline))
+			if err != nil {
+				return err
+			}
+		} else {
+			newTx, err = utils.SignStdTx(txCtx, cliCtx, name, stdTx, viper.GetBool(flagAppend), viper.GetBool(flagOffline))
+			if err != nil {
+				return err
+			}
 		}
 		var json []byte
 		if cliCtx.Indent {
@@ -16,4 +16,12 @@ const (
 	flagModule            = "module"
 	flagKey               = "key"
 	flagPath              = "path"
+	flagUsage             = "usage"
+	flagPercent           = "percent"
+	flagDestAddress       = "dest-address"
+	flagRecipients        = "recipients"
+	flagProtocolVersion   = "protocol-version"
+	flagSoftware          = "software"
+	flagSwitchHeight      = "switch-height"
+	flagChecksum          = "checksum"
 )
@@ -11,6 +11,9 @@ import (
 	client "github.com/irisnet/irishub/client/gov"
 	"github.com/irisnet/irishub/modules/gov"
 	"github.com/irisnet/irishub/modules/gov/params"
+	"github.com/irisnet/irishub/modules/upgrade/params"
+	"github.com/irisnet/irishub/modules/asset/params"
+	bam "github.com/irisnet/irishub/baseapp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/irisnet/irishub/modules/params"
@@ -204,6 +207,47 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryVoteChanges implements the command to query the vote changes on a proposal.
+func GetCmdQueryVoteChanges(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-vote-changes",
+		Short:   "query the vote changes on a proposal",
+		Example: "iriscli gov query-vote-changes --proposal-id=1 --voter=<voter address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			params := gov.QueryVoteChangesParams{
+				ProposalID: proposalID,
+			}
+			if voter := viper.GetString(flagVoter); len(voter) != 0 {
+				voterAddr, err := sdk.AccAddressFromBech32(voter)
+				if err != nil {
+					return err
+				}
+				params.Voter = voterAddr
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/vote_changes", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's vote changes are being queried")
+	cmd.Flags().String(flagVoter, "", "(optional) bech32 voter address, defaults to all the voters")
+
+	return cmd
+}
+
 // Command to Get a specific Deposit Information
 // GetCmdQueryDeposit implements the query proposal deposit command.
 func GetCmdQueryDeposit(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -311,6 +355,47 @@ func GetCmdQueryTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryTaxUsages implements the query of the spends of the community pool.
+func GetCmdQueryTaxUsages(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-tax-usages",
+		Short:   "Query the spends of the community pool, from the latest one",
+		Example: "iriscli gov query-tax-usages --usage=Grant --limit=10",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := gov.QueryTaxUsagesParams{
+				Limit: uint64(viper.GetInt64(flagNumLimit)),
+			}
+			if strUsage := viper.GetString(flagUsage); len(strUsage) > 0 {
+				usage, err := gov.UsageTypeFromString(client.NormalizeUsageType(strUsage))
+				if err != nil {
+					return err
+				}
+				params.Usage = usage
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryTaxUsages), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagUsage, "", "(optional) filter the spends by usage,eg:Burn/Distribute/Grant")
+	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] spends. Defaults to all spends")
+
+	return cmd
+}
+
 func GetCmdQueryGovConfig(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "query-params",
@@ -341,7 +426,14 @@ func GetCmdQueryGovConfig(storeName string, cdc *codec.Codec) *cobra.Command {
 						// 2.Error: The key in the module does not exist;
 						params.RegisterGovParamMapping(&govparams.DepositProcedureParameter,
 							&govparams.VotingProcedureParameter,
-							&govparams.TallyingProcedureParameter)
+							&govparams.TallyingProcedureParameter,
+							&govparams.ProposalProceduresParameter,
+							&govparams.DepositPolicyParameter,
+							&upgradeparams.SwitchPeriodParameter,
+							&upgradeparams.SwitchThresholdParameter,
+							&assetparams.IssueFeeParameter,
+							&assetparams.MintFeeParameter,
+							&bam.FeeExchangeRatesParameter)
 
 						res, err := ctx.QueryStore([]byte(keyStr), storeName)
 						return printKeyJsonIfExists(err, keyStr, res, cdc)
@@ -366,7 +458,14 @@ func GetCmdQueryGovConfig(storeName string, cdc *codec.Codec) *cobra.Command {
 				// 2.Error: The key in the module does not exist;
 				params.RegisterGovParamMapping(&govparams.DepositProcedureParameter,
 					&govparams.VotingProcedureParameter,
-					&govparams.TallyingProcedureParameter)
+					&govparams.TallyingProcedureParameter,
+					&govparams.ProposalProceduresParameter,
+					&govparams.DepositPolicyParameter,
+					&upgradeparams.SwitchPeriodParameter,
+					&upgradeparams.SwitchThresholdParameter,
+					&assetparams.IssueFeeParameter,
+					&assetparams.MintFeeParameter,
+					&bam.FeeExchangeRatesParameter)
 
 				res, err := ctx.QueryStore([]byte(keyStr), storeName)
 				return printKeyJsonIfExists(err, keyStr, res, cdc)
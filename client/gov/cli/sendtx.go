@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"encoding/json"
 
@@ -14,6 +15,7 @@ import (
 	client "github.com/irisnet/irishub/client/gov"
 	"github.com/irisnet/irishub/client/utils"
 	"github.com/irisnet/irishub/modules/gov"
+	"github.com/irisnet/irishub/modules/upgrade/params"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -54,19 +56,30 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 			////////////////////  iris begin  ///////////////////////////
-			var param gov.Param
+			var params gov.Params
 			if proposalType == gov.ProposalTypeParameterChange {
 				pathStr := viper.GetString(flagPath)
 				keyStr := viper.GetString(flagKey)
 				opStr := viper.GetString(flagOp)
-				param, err = getParamFromString(paramStr, pathStr, keyStr, opStr, cdc)
+				params, err = getParamsFromString(paramStr, pathStr, keyStr, opStr, cdc)
 				if err != nil {
 					return err
 				}
 			}
 			////////////////////  iris end  /////////////////////////////
 
-			msg := gov.NewMsgSubmitProposal(title, description, proposalType, fromAddr, amount, param)
+			msg := gov.NewMsgSubmitProposal(title, description, proposalType, fromAddr, amount, params)
+			////////////////////  iris begin  ///////////////////////////
+			if proposalType == gov.ProposalTypeSoftwareUpgrade {
+				upgradeMsg := gov.NewMsgSubmitSoftwareUpgradeProposal(msg, upgradeparams.UpgradeConfig{
+					ProtocolVersion: viper.GetInt64(flagProtocolVersion),
+					Software:        viper.GetString(flagSoftware),
+					SwitchHeight:    viper.GetInt64(flagSwitchHeight),
+					Checksum:        viper.GetString(flagChecksum),
+				})
+				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{upgradeMsg})
+			}
+			////////////////////  iris end  /////////////////////////////
 
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
@@ -77,38 +90,135 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal,eg:Text/ParameterChange/SoftwareUpgrade")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	////////////////////  iris begin  ///////////////////////////
-	cmd.Flags().String(flagParam, "", "parameter of proposal,eg. [{key:key,value:value,op:update}]")
-	cmd.Flags().String(flagKey, "", "the key of parameter")
+	cmd.Flags().String(flagParam, "", "parameters of proposal, a single one or a list applied together,eg. [{key:key,value:value,op:update}]")
+	cmd.Flags().String(flagKey, "", "the key of parameter, keys separated by commas for multiple parameters")
 	cmd.Flags().String(flagOp, "", "the operation of parameter")
 	cmd.Flags().String(flagPath, app.DefaultCLIHome, "the directory of the param.json")
+	cmd.Flags().Int64(flagProtocolVersion, 0, "protocol version of the new software, 0 for the next version")
+	cmd.Flags().String(flagSoftware, "", "where to download the new software")
+	cmd.Flags().Int64(flagSwitchHeight, 0, "height the upgrade switches at, 0 for the proposal accept height plus the switch period")
+	cmd.Flags().String(flagChecksum, "", "hex encoded sha256 of the new software binary")
 	////////////////////  iris end  /////////////////////////////
 	return cmd
 }
 
 ////////////////////  iris begin  ///////////////////////////
-func getParamFromString(paramStr string, pathStr string, keyStr string, opStr string, cdc *codec.Codec) (gov.Param, error) {
-	var param gov.Param
+func getParamsFromString(paramStr string, pathStr string, keyStr string, opStr string, cdc *codec.Codec) (gov.Params, error) {
+	var params gov.Params
 
 	if paramStr != "" {
+		// either a list of parameters or a single one
+		if strings.HasPrefix(strings.TrimSpace(paramStr), "[") {
+			err := json.Unmarshal([]byte(paramStr), &params)
+			return params, err
+		}
+		var param gov.Param
 		err := json.Unmarshal([]byte(paramStr), &param)
-		return param, err
+		return gov.Params{param}, err
 
 	} else if pathStr != "" {
 		paramDoc := gov.ParameterConfigFile{}
 		err := paramDoc.ReadFile(cdc, pathStr)
 		if err != nil {
-			return param, err
+			return params, err
+		}
+		for _, key := range strings.Split(keyStr, ",") {
+			param, err := paramDoc.GetParamFromKey(strings.TrimSpace(key), opStr)
+			if err != nil {
+				return params, err
+			}
+			params = append(params, param)
 		}
-		param, err := paramDoc.GetParamFromKey(keyStr, opStr)
-		return param, err
+		return params, nil
 	} else {
 
-		return param, errors.New("Path and param are both empty")
+		return params, errors.New("Path and param are both empty")
 	}
 }
 
 ////////////////////  iris end  /////////////////////////////
 
+// GetCmdSubmitTaxUsageProposal implements submitting a proposal to spend the community pool.
+func GetCmdSubmitTaxUsageProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-tax-usage-proposal",
+		Short:   "Submit a proposal to burn, distribute or grant a share of the community pool",
+		Example: "iriscli gov submit-tax-usage-proposal --chain-id=<chain-id> --from=<key name> --fee=0.004iris --title=grant --description=test --usage=Grant --percent=0.5 --dest-address=<trustee address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title := viper.GetString(flagTitle)
+			description := viper.GetString(flagDescription)
+			initialDeposit := viper.GetString(flagDeposit)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			amount, err := cliCtx.ParseCoins(initialDeposit)
+			if err != nil {
+				return err
+			}
+
+			usage, err := getTaxUsageFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitTaxUsageProposal(
+				gov.NewMsgSubmitProposal(title, description, gov.ProposalTypeCommunityTaxUsage, fromAddr, amount, nil),
+				usage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of proposal")
+	cmd.Flags().String(flagDescription, "", "description of proposal")
+	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagUsage, "", "usage of the community pool,eg:Burn/Distribute/Grant")
+	cmd.Flags().String(flagPercent, "", "share of the community pool to spend, in (0, 1]")
+	cmd.Flags().String(flagDestAddress, "", "the trustee receiving the grant")
+	cmd.Flags().String(flagRecipients, "", "the recipients sharing the distribution equally, separated by commas")
+	cmd.MarkFlagRequired(flagUsage)
+	cmd.MarkFlagRequired(flagPercent)
+	return cmd
+}
+
+func getTaxUsageFromFlags() (usage gov.TaxUsage, err error) {
+	usage.Usage, err = gov.UsageTypeFromString(client.NormalizeUsageType(viper.GetString(flagUsage)))
+	if err != nil {
+		return usage, err
+	}
+	usage.Percent, err = sdk.NewDecFromStr(viper.GetString(flagPercent))
+	if err != nil {
+		return usage, err
+	}
+	if destAddr := viper.GetString(flagDestAddress); len(destAddr) > 0 {
+		usage.DestAddress, err = sdk.AccAddressFromBech32(destAddr)
+		if err != nil {
+			return usage, err
+		}
+	}
+	if recipients := viper.GetString(flagRecipients); len(recipients) > 0 {
+		for _, bech := range strings.Split(recipients, ",") {
+			recipient, err := sdk.AccAddressFromBech32(strings.TrimSpace(bech))
+			if err != nil {
+				return usage, err
+			}
+			usage.Recipients = append(usage.Recipients, recipient)
+		}
+	}
+	return usage, nil
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -155,11 +265,48 @@ func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdCancelProposal implements the command of a proposer cancelling its proposal during the deposit period.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-proposal",
+		Short:   "cancel a proposal in its deposit period, only the proposer can cancel it",
+		Example: "iriscli gov cancel-proposal --chain-id=<chain-id> --from=<key name> --fee=0.004iris --proposal-id=1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			proposerAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			msg := gov.NewMsgCancelProposal(proposerAddr, proposalID)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal being cancelled")
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
 // GetCmdVote implements creating a new vote command.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "vote",
-		Short:   "vote for an active proposal, options: Yes/No/NoWithVeto/Abstain",
+		Short:   "vote for an active proposal, options: Yes/No/NoWithVeto/Abstain, or split the vote like Yes=0.6,No=0.4",
 		Example: "iriscli gov vote --chain-id=<chain-id> --from=<key name> --fee=0.004iris --proposal-id=1 --option=Yes",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().
@@ -177,20 +324,28 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 			proposalID := uint64(viper.GetInt64(flagProposalID))
 			option := viper.GetString(flagOption)
 
-			byteVoteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(option))
-			if err != nil {
-				return err
+			var msg sdk.Msg
+			if strings.Contains(option, "=") {
+				options, err := parseWeightedVoteOptions(option)
+				if err != nil {
+					return err
+				}
+				msg = gov.NewMsgVoteWeighted(voterAddr, proposalID, options)
+			} else {
+				byteVoteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(option))
+				if err != nil {
+					return err
+				}
+				msg = gov.NewMsgVote(voterAddr, proposalID, byteVoteOption)
 			}
 
-			msg := gov.NewMsgVote(voterAddr, proposalID, byteVoteOption)
-
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
 			fmt.Printf("Vote[Voter:%s,ProposalID:%d,Option:%s]",
-				voterAddr.String(), msg.ProposalID, msg.Option.String(),
+				voterAddr.String(), proposalID, option,
 			)
 			// Build and sign the transaction, then broadcast to a Tendermint
 			// node.
@@ -201,8 +356,30 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of proposal voting on")
-	cmd.Flags().String(flagOption, "", "vote option {Yes, No, NoWithVeto, Abstain}")
+	cmd.Flags().String(flagOption, "", "vote option {Yes, No, NoWithVeto, Abstain}, or weighted options like Yes=0.6,No=0.4")
 	cmd.MarkFlagRequired(flagProposalID)
 	cmd.MarkFlagRequired(flagOption)
 	return cmd
 }
+
+
+// parseWeightedVoteOptions parses weighted options like Yes=0.6,No=0.4
+func parseWeightedVoteOptions(str string) (gov.WeightedVoteOptions, error) {
+	var options gov.WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid weighted vote option %s, expected <option>=<weight>", pair)
+		}
+		option, err := gov.VoteOptionFromString(client.NormalizeVoteOption(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %s of option %s", fields[1], fields[0])
+		}
+		options = append(options, gov.WeightedVoteOption{Option: option, Weight: weight})
+	}
+	return options, nil
+}
@@ -6,5 +6,6 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestUsage          = "usage"
 	storeName          = "gov"
 )
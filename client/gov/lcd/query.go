@@ -1,6 +1,7 @@
 package lcd
 
 import (
+	"fmt"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/codec"
 	"github.com/gorilla/mux"
@@ -209,6 +210,88 @@ func queryVoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 	}
 }
 
+func queryVoteChangesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+		bechVoterAddr := r.URL.Query().Get(RestVoter)
+
+		if len(strProposalID) == 0 {
+			err := errors.New("proposalId required but not specified")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proposalID, ok := utils.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		params := gov.QueryVoteChangesParams{
+			ProposalID: proposalID,
+		}
+		if len(bechVoterAddr) != 0 {
+			voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Voter = voterAddr
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/gov/vote_changes", bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryTaxUsagesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strUsage := r.URL.Query().Get(RestUsage)
+		strNumLimit := r.URL.Query().Get(RestNumLimit)
+
+		params := gov.QueryTaxUsagesParams{}
+
+		if len(strUsage) != 0 {
+			usage, err := gov.UsageTypeFromString(client.NormalizeUsageType(strUsage))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Usage = usage
+		}
+		if len(strNumLimit) != 0 {
+			numLatest, ok := utils.ParseUint64OrReturnBadRequest(w, strNumLimit)
+			if !ok {
+				return
+			}
+			params.Limit = numLatest
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryTaxUsages), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 // todo: Split this functionality into helper functions to remove the above
 func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -245,6 +328,45 @@ func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 	}
 }
 
+func queryTaxUsagesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strUsage := r.URL.Query().Get(RestUsage)
+		strNumLimit := r.URL.Query().Get(RestNumLimit)
+
+		params := gov.QueryTaxUsagesParams{}
+
+		if len(strUsage) != 0 {
+			usage, err := gov.UsageTypeFromString(client.NormalizeUsageType(strUsage))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Usage = usage
+		}
+		if len(strNumLimit) != 0 {
+			numLatest, ok := utils.ParseUint64OrReturnBadRequest(w, strNumLimit)
+			if !ok {
+				return
+			}
+			params.Limit = numLatest
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryTaxUsages), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 // todo: Split this functionality into helper functions to remove the above
 func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -305,6 +427,45 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 	}
 }
 
+func queryTaxUsagesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		strUsage := r.URL.Query().Get(RestUsage)
+		strNumLimit := r.URL.Query().Get(RestNumLimit)
+
+		params := gov.QueryTaxUsagesParams{}
+
+		if len(strUsage) != 0 {
+			usage, err := gov.UsageTypeFromString(client.NormalizeUsageType(strUsage))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Usage = usage
+		}
+		if len(strNumLimit) != 0 {
+			numLatest, ok := utils.ParseUint64OrReturnBadRequest(w, strNumLimit)
+			if !ok {
+				return
+			}
+			params.Limit = numLatest
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryTaxUsages), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 // todo: Split this functionality into helper functions to remove the above
 func queryTallyOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
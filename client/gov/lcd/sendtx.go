@@ -8,6 +8,7 @@ import (
 	"github.com/irisnet/irishub/client/context"
 	"github.com/irisnet/irishub/client/utils"
 	"github.com/irisnet/irishub/modules/gov"
+	"github.com/irisnet/irishub/modules/upgrade/params"
 	"net/http"
 	client "github.com/irisnet/irishub/client/gov"
 )
@@ -19,7 +20,20 @@ type postProposalReq struct {
 	ProposalType   string           `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 	Proposer       sdk.AccAddress   `json:"proposer"`        //  Address of the proposer
 	InitialDeposit string           `json:"initial_deposit"` // Coins to add to the proposal's deposit
-	Param          gov.Param        `json:"param"`
+	Params         gov.Params       `json:"params"`
+	UpgradeConfig  upgradeparams.UpgradeConfig `json:"upgrade_config"` //  Software of a SoftwareUpgrade proposal
+}
+
+type postTaxUsageProposalReq struct {
+	BaseTx         context.BaseTx   `json:"base_tx"`
+	Title          string           `json:"title"`           //  Title of the proposal
+	Description    string           `json:"description"`     //  Description of the proposal
+	Proposer       sdk.AccAddress   `json:"proposer"`        //  Address of the proposer
+	InitialDeposit string           `json:"initial_deposit"` // Coins to add to the proposal's deposit
+	Usage          string           `json:"usage"`           //  Usage of the community pool {Burn, Distribute, Grant}
+	Percent        sdk.Dec          `json:"percent"`         //  Share of the community pool to spend
+	DestAddress    sdk.AccAddress   `json:"dest_address"`    //  Trustee receiving the grant
+	Recipients     []sdk.AccAddress `json:"recipients"`      //  Recipients sharing the distribution
 }
 
 type depositReq struct {
@@ -28,10 +42,16 @@ type depositReq struct {
 	Amount    string         `json:"amount"`    // Coins to add to the proposal's deposit
 }
 
+type cancelProposalReq struct {
+	BaseTx   context.BaseTx `json:"base_tx"`
+	Proposer sdk.AccAddress `json:"proposer"` // Address of the proposer
+}
+
 type voteReq struct {
 	BaseTx context.BaseTx `json:"base_tx"`
 	Voter  sdk.AccAddress `json:"voter"`  //  address of the voter
 	Option string `json:"option"` //  option from OptionSet chosen by the voter
+	Options gov.WeightedVoteOptions `json:"options"` //  weighted options splitting the vote, used instead of the option if given
 }
 
 func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -63,7 +83,66 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 		}
 
 		// create the message
-		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, proposalType, req.Proposer, initDepositAmount, req.Param)
+		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, proposalType, req.Proposer, initDepositAmount, req.Params)
+		if proposalType == gov.ProposalTypeSoftwareUpgrade {
+			upgradeMsg := gov.NewMsgSubmitSoftwareUpgradeProposal(msg, req.UpgradeConfig)
+			err = upgradeMsg.ValidateBasic()
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{upgradeMsg})
+			return
+		}
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func postTaxUsageProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+
+		var req postTaxUsageProposalReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		usageType, err := gov.UsageTypeFromString(client.NormalizeUsageType(req.Usage))
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		initDepositAmount, err := cliCtx.ParseCoins(req.InitialDeposit)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		usage := gov.TaxUsage{
+			Usage:       usageType,
+			Percent:     req.Percent,
+			DestAddress: req.DestAddress,
+			Recipients:  req.Recipients,
+		}
+		msg := gov.NewMsgSubmitTaxUsageProposal(
+			gov.NewMsgSubmitProposal(req.Title, req.Description, gov.ProposalTypeCommunityTaxUsage, req.Proposer, initDepositAmount, nil),
+			usage)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -119,7 +198,7 @@ func depositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 	}
 }
 
-func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+func cancelProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
 		cliCtx = utils.InitReqCliCtx(cliCtx, r)
@@ -137,7 +216,7 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		var req voteReq
+		var req cancelProposalReq
 		err := utils.ReadPostBody(w, r, cdc, &req)
 		if err != nil {
 			return
@@ -148,14 +227,59 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		voteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(req.Option))
+		// create the message
+		msg := gov.NewMsgCancelProposal(req.Proposer, proposalID)
+		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if len(strProposalID) == 0 {
+			err := errors.New("proposalId required but not specified")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proposalID, ok := utils.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		var req voteReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
 		// create the message
-		msg := gov.NewMsgVote(req.Voter, proposalID, voteOption)
+		var msg sdk.Msg
+		if len(req.Options) > 0 {
+			msg = gov.NewMsgVoteWeighted(req.Voter, proposalID, req.Options)
+		} else {
+			voteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(req.Option))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = gov.NewMsgVote(req.Voter, proposalID, voteOption)
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
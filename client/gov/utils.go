@@ -40,6 +40,8 @@ func NormalizeProposalType(proposalType string) string {
 		return "ParameterChange"
 	case "SoftwareUpgrade", "software_upgrade":
 		return "SoftwareUpgrade"
+	case "SoftwareUpgradeAbort", "software_upgrade_abort":
+		return "SoftwareUpgradeAbort"
 	}
 	return ""
 }
@@ -58,3 +60,16 @@ func NormalizeProposalStatus(status string) string {
 	}
 	return ""
 }
+
+//NormalizeUsageType - normalize user specified usage type of the community pool
+func NormalizeUsageType(usageType string) string {
+	switch usageType {
+	case "Burn", "burn":
+		return "Burn"
+	case "Distribute", "distribute":
+		return "Distribute"
+	case "Grant", "grant":
+		return "Grant"
+	}
+	return usageType
+}
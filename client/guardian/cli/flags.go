@@ -7,14 +7,17 @@ import (
 const (
 	FlagProfilerAddress = "profiler-address"
 	FlagProfilerName    = "profiler-name"
+	FlagTrusteeAddress  = "trustee-address"
 )
 
 var (
 	FsProfilerAddress = flag.NewFlagSet("", flag.ContinueOnError)
 	FsProfilerName    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTrusteeAddress  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsProfilerAddress.String(FlagProfilerAddress, "", "bech32 encoded account of the profiler")
 	FsProfilerName.String(FlagProfilerName, "", "name of the profiler")
+	FsTrusteeAddress.String(FlagTrusteeAddress, "", "bech32 encoded account of the trustee")
 }
@@ -1,13 +1,11 @@
 package cli
 
 import (
-	"os"
 	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/client/context"
-	authcmd "github.com/irisnet/irishub/client/auth/cli"
 	"github.com/irisnet/irishub/modules/guardian"
 )
 
@@ -17,20 +15,12 @@ func GetCmdQueryProfilers(storeName string, cdc *codec.Codec) *cobra.Command {
 		Short:   "Query for all profilers",
 		Example: "iriscli guardian profilers",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
-				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
-			res, err := cliCtx.QuerySubspace(guardian.GetProfilersSubspaceKey(), storeName)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, guardian.QueryProfilers), nil)
 			if err != nil {
 				return err
 			}
-			var profilers []guardian.Profiler
-			for i := 0; i < len(res); i++ {
-				var profiler guardian.Profiler
-				cdc.MustUnmarshalBinaryLengthPrefixed(res[i].Value, &profiler)
-				profilers = append(profilers, profiler)
-			}
-			output, err := codec.MarshalJSONIndent(cdc, profilers)
-			fmt.Println(string(output))
+			fmt.Println(string(res))
 			return nil
 		},
 	}
@@ -43,20 +33,12 @@ func GetCmdQueryTrustees(storeName string, cdc *codec.Codec) *cobra.Command {
 		Short:   "Query for all trustees",
 		Example: "iriscli guardian trustees",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
-				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
-			res, err := cliCtx.QuerySubspace(guardian.GetTrusteesSubspaceKey(), storeName)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, guardian.QueryTrustees), nil)
 			if err != nil {
 				return err
 			}
-			var trustees []guardian.Trustee
-			for i := 0; i < len(res); i++ {
-				var trustee guardian.Trustee
-				cdc.MustUnmarshalBinaryLengthPrefixed(res[i].Value, &trustee)
-				trustees = append(trustees, trustee)
-			}
-			output, err := codec.MarshalJSONIndent(cdc, trustees)
-			fmt.Println(string(output))
+			fmt.Println(string(res))
 			return nil
 		},
 	}
@@ -49,4 +49,100 @@ func GetCmdCreateProfiler(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(FsProfilerAddress)
 	cmd.Flags().AddFlagSet(FsProfilerName)
 	return cmd
-}
\ No newline at end of file
+}
+
+func GetCmdDeleteProfiler(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-profiler",
+		Short: "Delete a profiler, only a trustee is allowed",
+		Example: "iriscli guardian delete-profiler --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--profiler-address=<deleted address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			paStr := viper.GetString(FlagProfilerAddress)
+			if len(paStr) == 0 {
+				return fmt.Errorf("must use --profiler-address flag")
+			}
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			msg := guardian.NewMsgDeleteProfiler(pAddr, fromAddr)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsProfilerAddress)
+	return cmd
+}
+
+func GetCmdAddTrustee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-trustee",
+		Short: "Approve adding a new trustee, it is added once more than half of the trustees approve",
+		Example: "iriscli guardian add-trustee --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--trustee-address=<added address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			taStr := viper.GetString(FlagTrusteeAddress)
+			if len(taStr) == 0 {
+				return fmt.Errorf("must use --trustee-address flag")
+			}
+			tAddr, err := sdk.AccAddressFromBech32(taStr)
+			if err != nil {
+				return err
+			}
+			msg := guardian.NewMsgAddTrustee(tAddr, fromAddr)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsTrusteeAddress)
+	return cmd
+}
+
+func GetCmdDeleteTrustee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-trustee",
+		Short: "Approve deleting a trustee, it is deleted once more than half of the trustees approve and the last trustee can not be deleted",
+		Example: "iriscli guardian delete-trustee --chain-id=<chain-id> --from=<key name> --fee=0.004iris " +
+			"--trustee-address=<deleted address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			taStr := viper.GetString(FlagTrusteeAddress)
+			if len(taStr) == 0 {
+				return fmt.Errorf("must use --trustee-address flag")
+			}
+			tAddr, err := sdk.AccAddressFromBech32(taStr)
+			if err != nil {
+				return err
+			}
+			msg := guardian.NewMsgDeleteTrustee(tAddr, fromAddr)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsTrusteeAddress)
+	return cmd
+}
@@ -0,0 +1,39 @@
+package lcd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/guardian"
+)
+
+const storeName = "guardian"
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	// Get all profilers
+	r.HandleFunc(
+		"/guardian/profilers",
+		queryHandlerFn(cliCtx, cdc, guardian.QueryProfilers),
+	).Methods("GET")
+
+	// Get all trustees
+	r.HandleFunc(
+		"/guardian/trustees",
+		queryHandlerFn(cliCtx, cdc, guardian.QueryTrustees),
+	).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, path), nil)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,13 @@
+package lcd
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/codec"
+)
+
+// RegisterRoutes registers guardian REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	registerQueryRoutes(cliCtx, r, cdc)
+	registerTxRoutes(cliCtx, r, cdc)
+}
@@ -0,0 +1,205 @@
+package lcd
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/guardian"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	// add a profiler
+	r.HandleFunc(
+		"/guardian/profilers",
+		addProfilerHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// delete a profiler
+	r.HandleFunc(
+		"/guardian/profilers/delete",
+		deleteProfilerHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// add a trustee
+	r.HandleFunc(
+		"/guardian/trustees",
+		addTrusteeHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// delete a trustee
+	r.HandleFunc(
+		"/guardian/trustees/delete",
+		deleteTrusteeHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+}
+
+type addProfilerReq struct {
+	BaseTx  context.BaseTx `json:"base_tx"`  // basic tx info
+	AddedBy string         `json:"added_by"` // address of the existing profiler
+	Addr    string         `json:"addr"`     // address of the new profiler
+	Name    string         `json:"name"`
+}
+
+type deleteGuardianReq struct {
+	BaseTx    context.BaseTx `json:"base_tx"`    // basic tx info
+	DeletedBy string         `json:"deleted_by"` // address of the trustee
+	Addr      string         `json:"addr"`       // address of the deleted profiler or trustee
+}
+
+type addTrusteeReq struct {
+	BaseTx  context.BaseTx `json:"base_tx"`  // basic tx info
+	AddedBy string         `json:"added_by"` // address of the existing trustee
+	Addr    string         `json:"addr"`     // address of the new trustee
+}
+
+func addProfilerHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+
+		var req addProfilerReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		addedBy, err := sdk.AccAddressFromBech32(req.AddedBy)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(req.Addr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := guardian.NewMsgAddProfiler(addr, addedBy, req.Name)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func deleteProfilerHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+
+		var req deleteGuardianReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		deletedBy, err := sdk.AccAddressFromBech32(req.DeletedBy)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(req.Addr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := guardian.NewMsgDeleteProfiler(addr, deletedBy)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func addTrusteeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+
+		var req addTrusteeReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		addedBy, err := sdk.AccAddressFromBech32(req.AddedBy)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(req.Addr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := guardian.NewMsgAddTrustee(addr, addedBy)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func deleteTrusteeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+
+		var req deleteGuardianReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		deletedBy, err := sdk.AccAddressFromBech32(req.DeletedBy)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		addr, err := sdk.AccAddressFromBech32(req.Addr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := guardian.NewMsgDeleteTrustee(addr, deletedBy)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/irisnet/irishub/client"
 	"github.com/irisnet/irishub/client/keys"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/libs/cli"
 )
 
@@ -21,6 +23,7 @@ const (
 	flagDryRun   = "dry-run"
 	flagAccount  = "account"
 	flagIndex    = "index"
+	flagMultisig = "multisig"
 )
 
 func addKeyCommand() *cobra.Command {
@@ -29,7 +32,10 @@ func addKeyCommand() *cobra.Command {
 		Short: "Create a new key, or import from seed",
 		Long: `Add a public/private key pair to the key store.
 If you select --seed/-s you can recover a key from the seed
-phrase, otherwise, a new key will be generated.`,
+phrase, otherwise, a new key will be generated.
+
+Use --multisig to store a multisig public key made of keys already in the
+key store, the key signs nothing itself and is used by "iriscli bank multisign".`,
 		Example: "iriscli keys add <key name>",
 		RunE: runAddCmd,
 	}
@@ -40,6 +46,8 @@ phrase, otherwise, a new key will be generated.`,
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Index number for HD derivation")
+	cmd.Flags().StringSlice(flagMultisig, nil, "Construct and store a multisig public key from the given comma separated key names")
+	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures, used with --multisig")
 	return cmd
 }
 
@@ -76,6 +84,11 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		multisigKeys := viper.GetStringSlice(flagMultisig)
+		if len(multisigKeys) != 0 {
+			return addMultisigKey(kb, name, multisigKeys)
+		}
+
 		// ask for a password when generating a local key
 		if !viper.GetBool(client.FlagUseLedger) {
 			pass, err = keys.GetCheckPassword(
@@ -121,6 +134,31 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// addMultisigKey stores an offline reference to the multisig public key of the given keys
+func addMultisigKey(kb cryptokeys.Keybase, name string, keyNames []string) error {
+	multisigThreshold := viper.GetInt(flagMultiSigThreshold)
+	if err := validateMultisigThreshold(multisigThreshold, len(keyNames)); err != nil {
+		return err
+	}
+
+	pks := make([]crypto.PubKey, len(keyNames))
+	for i, keyName := range keyNames {
+		info, err := kb.Get(keyName)
+		if err != nil {
+			return err
+		}
+		pks[i] = info.GetPubKey()
+	}
+
+	info, err := kb.CreateOffline(name, multisig.NewPubKeyMultisigThreshold(multisigThreshold, pks))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Key %q saved to disk.\n", name)
+	keys.PrintKeyInfo(info, keys.Bech32KeyOutput)
+	return nil
+}
+
 func printCreate(info cryptokeys.Info, seed string) {
 	output := viper.Get(cli.OutputFlag)
 	switch output {
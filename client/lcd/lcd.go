@@ -12,6 +12,7 @@ import (
 	"github.com/irisnet/irishub/client/context"
 	distributionhandler "github.com/irisnet/irishub/client/distribution/lcd"
 	govhandler "github.com/irisnet/irishub/client/gov/lcd"
+	guardianhandler "github.com/irisnet/irishub/client/guardian/lcd"
 	keyshandler "github.com/irisnet/irishub/client/keys/lcd"
 	recordhandle "github.com/irisnet/irishub/client/record/lcd"
 	servicehandle "github.com/irisnet/irishub/client/service/lcd"
@@ -105,6 +106,7 @@ func createHandler(cdc *codec.Codec) *mux.Router {
 	govhandler.RegisterRoutes(cliCtx, r, cdc)
 	recordhandle.RegisterRoutes(cliCtx, r, cdc)
 	servicehandle.RegisterRoutes(cliCtx, r, cdc)
+	guardianhandler.RegisterRoutes(cliCtx, r, cdc)
 	// tendermint apis
 	rpchandler.RegisterRoutes(cliCtx, r, cdc)
 	txhandler.RegisterRoutes(cliCtx, r, cdc)
@@ -2,15 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/client/context"
-	"github.com/irisnet/irishub/modules/record"
+	recordClient "github.com/irisnet/irishub/client/record"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-	"github.com/ipfs/go-ipfs-api"
 	"github.com/irisnet/irishub/app"
 )
 
@@ -27,14 +27,11 @@ func GetCmdDownload(storeName string, cdc *codec.Codec) *cobra.Command {
 			path := viper.GetString(flagPath)
 			recordID := viper.GetString(flagRecordID)
 
-			res, err := cliCtx.QueryStore([]byte(recordID), storeName)
-			if len(res) == 0 || err != nil {
+			submitFile, err := recordClient.QueryRecord(cliCtx, cdc, recordID)
+			if err != nil {
 				return fmt.Errorf("Record id [%s] doesn't exist", recordID)
 			}
 
-			var submitFile record.MsgSubmitRecord
-			cdc.MustUnmarshalBinaryLengthPrefixed(res, &submitFile)
-
 			filePath := filepath.Join(path, downloadFileName)
 			if _, err := os.Stat(filePath); !os.IsNotExist(err) {
 				fmt.Printf("Warning: %v already exists, please try another file name.\n", filePath)
@@ -64,14 +61,23 @@ func GetCmdDownload(storeName string, cdc *codec.Codec) *cobra.Command {
 				}
 				fmt.Println("[ONCHAIN] Download file from blockchain complete.")
 			} else {
-				//Begin to download file from ipfs
-				fmt.Printf("[IPFS] Downloading %v from ipfs...\n", filePath)
-				sh := shell.NewShell(pinedNode)
-				err = sh.Get(submitFile.DataHash, filePath)
+				//Begin to download file from the offchain storage
+				fmt.Printf("[OFFCHAIN] Downloading %v from %s...\n", filePath, submitFile.DataURI)
+				storage, err := recordClient.NewStorage(submitFile.DataURI, pinedNode)
+				if err != nil {
+					return err
+				}
+				data, err := storage.Get(submitFile.DataURI)
 				if err != nil {
 					return err
 				}
-				fmt.Println("[IPFS] Download file from ipfs complete.")
+				if err := recordClient.VerifyData(data, submitFile.DataHash); err != nil {
+					return err
+				}
+				if err := ioutil.WriteFile(filePath, data, 0644); err != nil {
+					return err
+				}
+				fmt.Println("[OFFCHAIN] Download file from offchain storage complete.")
 			}
 			return nil
 		},
@@ -80,6 +86,7 @@ func GetCmdDownload(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagRecordID, "", "record ID")
 	cmd.Flags().String(flagFileName, "", "download file name")
 	cmd.Flags().String(flagPath, app.DefaultCLIHome, "the directory to store the downloads")
+	cmd.Flags().String(flagPinedNode, defaultIPFSNode, "the IPFS node to download ipfs:// records from")
 
 	return cmd
 }
@@ -9,4 +9,13 @@ const (
 	flagTargetPath  = "target-path"
 	flagRecordID    = "record-id"
 	flagPath        = "path"
+	flagOwner       = "owner"
+	flagStartTime   = "start-time"
+	flagEndTime     = "end-time"
+	flagPage        = "page"
+	flagLimit       = "limit"
+	flagReason      = "reason"
+	flagStorage     = "storage"
 )
+
+const defaultIPFSNode = "localhost:5001"
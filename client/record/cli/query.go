@@ -31,14 +31,11 @@ func GetCmdQureyRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			recordID := viper.GetString(flagRecordID)
 
-			res, err := cliCtx.QueryStore([]byte(recordID), storeName)
-			if len(res) == 0 || err != nil {
-				return fmt.Errorf("Record ID [%s] doesn't exist", recordID)
+			submitRecord, err := recordClient.QueryRecord(cliCtx, cdc, recordID)
+			if err != nil {
+				return err
 			}
 
-			var submitRecord record.MsgSubmitRecord
-			cdc.MustUnmarshalBinaryLengthPrefixed(res, &submitRecord)
-
 			recordResponse, err := recordClient.ConvertRecordToRecordOutput(cliCtx, submitRecord)
 			if err != nil {
 				return err
@@ -59,3 +56,78 @@ func GetCmdQureyRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdQueryRecords(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "records",
+		Short:   "query records of an owner",
+		Example: "iriscli record records --owner=<owner address> [--start-time=<unix time>] [--end-time=<unix time>] [--page=1] [--limit=100]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(viper.GetString(flagOwner))
+			if err != nil {
+				return err
+			}
+
+			params := record.QueryRecordsParams{
+				Owner:     owner,
+				StartTime: viper.GetInt64(flagStartTime),
+				EndTime:   viper.GetInt64(flagEndTime),
+				Page:      viper.GetUint64(flagPage),
+				Limit:     viper.GetUint64(flagLimit),
+			}
+
+			records, err := recordClient.QueryRecords(cliCtx, cdc, params)
+			if err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, records)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "bech32 encoded address of the record owner")
+	cmd.Flags().Int64(flagStartTime, 0, "(optional) unix time from which the records were submitted")
+	cmd.Flags().Int64(flagEndTime, 0, "(optional) unix time until which the records were submitted, no limit if 0")
+	cmd.Flags().Uint64(flagPage, 1, "page of the query results, starting from 1")
+	cmd.Flags().Uint64(flagLimit, 100, "maximum number of the query results per page")
+
+	return cmd
+}
+
+func GetCmdQueryRecordHistory(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "query the status, versions and history of a record",
+		Example: "iriscli record history --chain-id=<chain-id> --record-id=<record-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(record.QueryRecordParams{RecordID: viper.GetString(flagRecordID)})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, record.QueryHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagRecordID, "", "record ID for query")
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/irisnet/irishub/modules/record"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	recordClient "github.com/irisnet/irishub/client/record"
 )
 
 // GetCmdSubmitFile implements submitting upload file transaction command.
@@ -23,7 +25,8 @@ func GetCmdSubmitRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "submit",
 		Short:   "Submit a new record",
-		Example: "iriscli record submit --chain-id=<chain-id> --description=<record description> --onchain-data=<record data> --from=<key name> --fee=0.004iris",
+		Example: "iriscli record submit --chain-id=<chain-id> --description=<record description> --onchain-data=<record data> --from=<key name> --fee=0.004iris\n" +
+			"iriscli record submit --chain-id=<chain-id> --description=<record description> --file-path=<file> --storage=<storage> --from=<key name> --fee=0.004iris",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			description := viper.GetString(flagDescription)
 			onchainData := viper.GetString(flagOnchainData)
@@ -43,8 +46,17 @@ func GetCmdSubmitRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			sum := sha256.Sum256([]byte(onchainData))
-			recordHash := hex.EncodeToString(sum[:])
+			// --onchain-data has a high priority over --file-path
+			data := []byte(onchainData)
+			filePath := viper.GetString(flagFilePath)
+			if len(onchainData) == 0 && len(filePath) != 0 {
+				data, err = ioutil.ReadFile(filePath)
+				if err != nil {
+					return err
+				}
+			}
+
+			recordHash := recordClient.GetDataHash(data)
 
 			recordID := record.KeyRecord(recordHash)
 			res, err := cliCtx.QueryStore([]byte(recordID), storeName)
@@ -58,7 +70,7 @@ func GetCmdSubmitRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 			}
 
 			submitTime := time.Now().Unix()
-			dataSize := int64(binary.Size([]byte(onchainData)))
+			dataSize := int64(len(data))
 			msg := record.NewMsgSubmitRecord(
 				description,
 				submitTime,
@@ -68,6 +80,28 @@ func GetCmdSubmitRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 				onchainData,
 			)
 
+			if len(onchainData) == 0 && len(filePath) != 0 {
+				// upload the file to the offchain storage
+				storage, err := recordClient.NewStorage(viper.GetString(flagStorage), viper.GetString(flagPinedNode))
+				if err != nil {
+					return err
+				}
+				dataURI, err := storage.Put(data)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("[OFFCHAIN] Uploaded %v to %s\n", filePath, dataURI)
+
+				msg = record.NewMsgSubmitOffchainRecord(
+					description,
+					submitTime,
+					fromAddr,
+					recordHash,
+					dataSize,
+					dataURI,
+				)
+			}
+
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
 	}
@@ -78,5 +112,133 @@ func GetCmdSubmitRecord(storeName string, cdc *codec.Codec) *cobra.Command {
 	// onchain flag
 	cmd.Flags().String(flagOnchainData, "", "on chain data source")
 
+	// offchain flag
+	cmd.Flags().String(flagFilePath, "", "path of the file to upload to the offchain storage")
+	cmd.Flags().String(flagStorage, "ipfs://", "offchain storage to upload the file to, ipfs://, http(s)://<endpoint> or file://<directory>")
+	cmd.Flags().String(flagPinedNode, defaultIPFSNode, "the IPFS node to upload the file to")
+
+	return cmd
+}
+
+// GetCmdAmendRecord implements amending the description of a record command.
+func GetCmdAmendRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend",
+		Short:   "Amend the description of a record",
+		Example: "iriscli record amend --chain-id=<chain-id> --record-id=<record-id> --description=<new description> --from=<key name> --fee=0.004iris",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := record.NewMsgAmendRecord(viper.GetString(flagRecordID), fromAddr, viper.GetString(flagDescription))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRecordID, "", "record ID")
+	cmd.Flags().String(flagDescription, "", "new record description")
+
+	return cmd
+}
+
+// GetCmdSupersedeRecord implements superseding a record with new data command.
+func GetCmdSupersedeRecord(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supersede",
+		Short:   "Supersede a record with a new version",
+		Example: "iriscli record supersede --chain-id=<chain-id> --record-id=<record-id> --description=<record description> --onchain-data=<record data> --from=<key name> --fee=0.004iris",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			description := viper.GetString(flagDescription)
+			onchainData := viper.GetString(flagOnchainData)
+
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sum := sha256.Sum256([]byte(onchainData))
+			recordHash := hex.EncodeToString(sum[:])
+
+			recordID := record.KeyRecord(recordHash)
+			res, err := cliCtx.QueryStore([]byte(recordID), storeName)
+			if err != nil {
+				return err
+			}
+			if len(res) != 0 {
+				return fmt.Errorf("record ID %v already exists", string(recordID))
+			}
+
+			newRecord := record.NewMsgSubmitRecord(
+				description,
+				time.Now().Unix(),
+				fromAddr,
+				recordHash,
+				int64(binary.Size([]byte(onchainData))),
+				onchainData,
+			)
+			msg := record.NewMsgSupersedeRecord(viper.GetString(flagRecordID), newRecord)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRecordID, "", "ID of the record to supersede")
+	cmd.Flags().String(flagDescription, "description", "description of the new version")
+	cmd.Flags().String(flagOnchainData, "", "on chain data of the new version")
+
+	return cmd
+}
+
+// GetCmdRevokeRecord implements revoking a record command.
+func GetCmdRevokeRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke",
+		Short:   "Revoke a record",
+		Example: "iriscli record revoke --chain-id=<chain-id> --record-id=<record-id> --reason=<reason> --from=<key name> --fee=0.004iris",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := record.NewMsgRevokeRecord(viper.GetString(flagRecordID), fromAddr, viper.GetString(flagReason))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRecordID, "", "record ID")
+	cmd.Flags().String(flagReason, "", "reason of the revocation")
+
 	return cmd
 }
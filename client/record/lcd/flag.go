@@ -3,5 +3,9 @@ package lcd
 const (
 	RestRecordID       = "recordID"
 	RestAccountAddress = "accountAddress"
+	RestStartTime      = "start-time"
+	RestEndTime        = "end-time"
+	RestPage           = "page"
+	RestLimit          = "limit"
 	storeName          = "record"
 )
@@ -10,6 +10,7 @@ import (
 	recordClient "github.com/irisnet/irishub/client/record"
 	"github.com/irisnet/irishub/client/utils"
 	"github.com/irisnet/irishub/modules/record"
+	sdk "github.com/irisnet/irishub/types"
 )
 
 // nolint: gocyclo
@@ -22,20 +23,12 @@ func queryRecordsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext) ht
 			return
 		}
 
-		res, err := cliCtx.QueryStore([]byte(recordID), storeName)
+		submitFile, err := recordClient.QueryRecord(cliCtx, cdc, recordID)
 		if err != nil {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "no exist yet and record ID has not been set")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		if len(res) == 0 || err != nil {
-			utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Record ID [%s] is not existe", recordID))
-			return
-		}
-
-		var submitFile record.MsgSubmitRecord
-		cdc.MustUnmarshalBinaryLengthPrefixed(res, &submitFile)
-
 		recordResponse, err := recordClient.ConvertRecordToRecordOutput(cliCtx, submitFile)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -61,27 +54,69 @@ func queryRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 			return
 		}
 
-		res, err := cliCtx.QueryStore([]byte(recordID), storeName)
+		submitFile, err := recordClient.QueryRecord(cliCtx, cdc, recordID)
 		if err != nil {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "no exist yet and record ID has not been set")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		if len(res) == 0 || err != nil {
-			utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Record ID [%s] is not existe", recordID))
+		recordResponse, err := recordClient.ConvertRecordToRecordOutput(cliCtx, submitFile)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		var submitFile record.MsgSubmitRecord
-		cdc.MustUnmarshalBinaryLengthPrefixed(res, &submitFile)
+		output, err := codec.MarshalJSONIndent(cdc, recordResponse)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 
-		recordResponse, err := recordClient.ConvertRecordToRecordOutput(cliCtx, submitFile)
+		w.Write(output)
+	}
+}
+
+func queryOwnerRecordsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		owner, err := sdk.AccAddressFromBech32(vars[RestAccountAddress])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := record.QueryRecordsParams{
+			Owner: owner,
+		}
+		var ok bool
+		if startTime := r.URL.Query().Get(RestStartTime); len(startTime) > 0 {
+			if params.StartTime, ok = utils.ParseInt64OrReturnBadRequest(w, startTime); !ok {
+				return
+			}
+		}
+		if endTime := r.URL.Query().Get(RestEndTime); len(endTime) > 0 {
+			if params.EndTime, ok = utils.ParseInt64OrReturnBadRequest(w, endTime); !ok {
+				return
+			}
+		}
+		if page := r.URL.Query().Get(RestPage); len(page) > 0 {
+			if params.Page, ok = utils.ParseUint64OrReturnBadRequest(w, page); !ok {
+				return
+			}
+		}
+		if limit := r.URL.Query().Get(RestLimit); len(limit) > 0 {
+			if params.Limit, ok = utils.ParseUint64OrReturnBadRequest(w, limit); !ok {
+				return
+			}
+		}
+
+		records, err := recordClient.QueryRecords(cliCtx, cdc, params)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		output, err := codec.MarshalJSONIndent(cdc, recordResponse)
+		output, err := codec.MarshalJSONIndent(cdc, records)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -90,3 +125,26 @@ func queryRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 		w.Write(output)
 	}
 }
+
+func queryRecordHistoryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		params := record.QueryRecordParams{
+			RecordID: vars[RestRecordID],
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, record.QueryHistory), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Write(res)
+	}
+}
@@ -15,6 +15,16 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec)
 
 	r.HandleFunc(fmt.Sprintf("/record/records/{%s}", RestRecordID), queryRecordHandlerFn(cdc, cliCtx)).Methods("GET")
 
+	r.HandleFunc(fmt.Sprintf("/record/records/{%s}/history", RestRecordID), queryRecordHistoryHandlerFn(cdc, cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/record/records/{%s}/amend", RestRecordID), amendRecordHandlerFn(cdc, cliCtx)).Methods("POST")
+
+	r.HandleFunc(fmt.Sprintf("/record/records/{%s}/supersede", RestRecordID), supersedeRecordHandlerFn(cdc, cliCtx)).Methods("POST")
+
+	r.HandleFunc(fmt.Sprintf("/record/records/{%s}/revoke", RestRecordID), revokeRecordHandlerFn(cdc, cliCtx)).Methods("POST")
+
 	r.HandleFunc("/record/records", queryRecordsWithParameterFn(cdc, cliCtx)).Methods("GET")
 
+	r.HandleFunc(fmt.Sprintf("/record/owners/{%s}/records", RestAccountAddress), queryOwnerRecordsHandlerFn(cdc, cliCtx)).Methods("GET")
+
 }
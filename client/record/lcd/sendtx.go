@@ -13,13 +13,17 @@ import (
 	"github.com/irisnet/irishub/client/context"
 	"github.com/irisnet/irishub/client/utils"
 	"github.com/irisnet/irishub/modules/record"
+	"github.com/gorilla/mux"
 )
 
 type postRecordReq struct {
 	BaseTx      context.BaseTx `json:"base_tx"`   // basic tx info
 	Submitter   string         `json:"submitter"` //  Address of the submitter
 	Description string         `json:"description"`
-	Data        string         `json:"data"` // for onchain
+	Data        string         `json:"data"`      // for onchain
+	DataURI     string         `json:"data_uri"`  // for offchain, the data is uploaded by the submitter
+	DataHash    string         `json:"data_hash"` // for offchain, hex encoded sha256 hash of the data
+	DataSize    int64          `json:"data_size"` // for offchain
 }
 
 func postRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -59,6 +63,9 @@ func postRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 
 			sum := sha256.Sum256([]byte(onchainData))
 			recordHash = hex.EncodeToString(sum[:])
+		} else if len(req.DataURI) != 0 {
+			recordHash = req.DataHash
+			dataSize = req.DataSize
 		} else {
 			utils.WriteErrorResponse(w, http.StatusBadRequest,
 				"--onchain-data is empty and pleae double check this option")
@@ -80,6 +87,132 @@ func postRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 
 		// create the message
 		msg := record.NewMsgSubmitRecord(req.Description, submitTime, submitter, recordHash, dataSize, onchainData)
+		if len(onchainData) == 0 {
+			msg = record.NewMsgSubmitOffchainRecord(req.Description, submitTime, submitter, recordHash, dataSize, req.DataURI)
+		}
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+type amendRecordReq struct {
+	BaseTx      context.BaseTx `json:"base_tx"` // basic tx info
+	Owner       string         `json:"owner"`   // Address of the record owner
+	Description string         `json:"description"`
+}
+
+type supersedeRecordReq struct {
+	BaseTx      context.BaseTx `json:"base_tx"` // basic tx info
+	Owner       string         `json:"owner"`   // Address of the record owner
+	Description string         `json:"description"`
+	Data        string         `json:"data"` // onchain data of the new version
+}
+
+type revokeRecordReq struct {
+	BaseTx context.BaseTx `json:"base_tx"` // basic tx info
+	Owner  string         `json:"owner"`   // Address of the record owner
+	Reason string         `json:"reason"`
+}
+
+func amendRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+		recordID := mux.Vars(r)[RestRecordID]
+
+		var req amendRecordReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := record.NewMsgAmendRecord(recordID, owner, req.Description)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func supersedeRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+		recordID := mux.Vars(r)[RestRecordID]
+
+		var req supersedeRecordReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		sum := sha256.Sum256([]byte(req.Data))
+		recordHash := hex.EncodeToString(sum[:])
+		dataSize := int64(binary.Size([]byte(req.Data)))
+
+		newRecord := record.NewMsgSubmitRecord(req.Description, time.Now().Unix(), owner, recordHash, dataSize, req.Data)
+		msg := record.NewMsgSupersedeRecord(recordID, newRecord)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.SendOrReturnUnsignedTx(w, cliCtx, req.BaseTx, []sdk.Msg{msg})
+	}
+}
+
+func revokeRecordHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = utils.InitReqCliCtx(cliCtx, r)
+		recordID := mux.Vars(r)[RestRecordID]
+
+		var req revokeRecordReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w, cliCtx) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := record.NewMsgRevokeRecord(recordID, owner, req.Reason)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
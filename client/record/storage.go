@@ -0,0 +1,163 @@
+package record
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-ipfs-api"
+)
+
+const (
+	ipfsScheme  = "ipfs://"
+	httpScheme  = "http://"
+	httpsScheme = "https://"
+	fileScheme  = "file://"
+)
+
+// Storage is an offchain storage backend of record data, the data is located by an URI
+type Storage interface {
+	// Put stores the data and returns its URI
+	Put(data []byte) (string, error)
+	// Get reads the data located by the URI
+	Get(uri string) ([]byte, error)
+}
+
+// NewStorage selects the storage backend by the scheme of the location:
+// ipfs:// uses the IPFS node, http(s):// an HTTP endpoint and file:// a local content-addressed directory.
+// The location can either be the URI of some data or the base location to put data to.
+func NewStorage(location string, ipfsNode string) (Storage, error) {
+	switch {
+	case strings.HasPrefix(location, ipfsScheme):
+		return NewIPFSStorage(ipfsNode), nil
+	case strings.HasPrefix(location, httpScheme), strings.HasPrefix(location, httpsScheme):
+		return NewHTTPStorage(location), nil
+	case strings.HasPrefix(location, fileScheme):
+		return NewLocalStorage(strings.TrimPrefix(location, fileScheme)), nil
+	default:
+		return nil, fmt.Errorf("unsupported storage location %s", location)
+	}
+}
+
+// GetDataHash returns the hex encoded sha256 hash of the data, which is the data hash of a record
+func GetDataHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyData checks the data against the data hash of a record
+func VerifyData(data []byte, dataHash string) error {
+	if hash := GetDataHash(data); hash != dataHash {
+		return fmt.Errorf("data hash %s does not match the record data hash %s", hash, dataHash)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// IPFSStorage keeps the data in IPFS through the API of a (pinned) node
+type IPFSStorage struct {
+	sh *shell.Shell
+}
+
+func NewIPFSStorage(node string) IPFSStorage {
+	return IPFSStorage{sh: shell.NewShell(node)}
+}
+
+func (s IPFSStorage) Put(data []byte) (string, error) {
+	cid, err := s.sh.Add(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	return ipfsScheme + cid, nil
+}
+
+func (s IPFSStorage) Get(uri string) ([]byte, error) {
+	reader, err := s.sh.Cat(strings.TrimPrefix(uri, ipfsScheme))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+//______________________________________________________________________
+
+// HTTPStorage puts the data to an HTTP endpoint under its hash, and gets the data from any http(s) URI
+type HTTPStorage struct {
+	endpoint string
+	client   *http.Client
+}
+
+func NewHTTPStorage(endpoint string) HTTPStorage {
+	return HTTPStorage{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (s HTTPStorage) Put(data []byte) (string, error) {
+	uri := fmt.Sprintf("%s/%s", s.endpoint, GetDataHash(data))
+	req, err := http.NewRequest(http.MethodPut, uri, bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusNoContent {
+		return "", fmt.Errorf("failed to put data to %s: %s", uri, res.Status)
+	}
+	return uri, nil
+}
+
+func (s HTTPStorage) Get(uri string) ([]byte, error) {
+	res, err := s.client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get data from %s: %s", uri, res.Status)
+	}
+	return ioutil.ReadAll(res.Body)
+}
+
+//______________________________________________________________________
+
+// LocalStorage keeps the data in a local directory, the file of the data is named by its hash
+type LocalStorage struct {
+	dir string
+}
+
+func NewLocalStorage(dir string) LocalStorage {
+	return LocalStorage{dir: dir}
+}
+
+func (s LocalStorage) Put(data []byte) (string, error) {
+	dir, err := filepath.Abs(s.dir)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, GetDataHash(data))
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return fileScheme + path, nil
+}
+
+func (s LocalStorage) Get(uri string) ([]byte, error) {
+	return ioutil.ReadFile(strings.TrimPrefix(uri, fileScheme))
+}
@@ -1,10 +1,12 @@
 package record
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/modules/record"
 )
 
@@ -16,6 +18,7 @@ type RecordOutput struct {
 	DataHash     string         `json:"data_hash"`   // Data/file hash
 	DataSize     int64          `json:"data_size"`   // Data/file Size in bytes
 	Data         string         `json:"data"`        // Onchain data
+	DataURI      string         `json:"data_uri"`    // Offchain data location
 }
 
 func ConvertRecordToRecordOutput(cliCtx context.CLIContext, r record.MsgSubmitRecord) (RecordOutput, error) {
@@ -30,7 +33,53 @@ func ConvertRecordToRecordOutput(cliCtx context.CLIContext, r record.MsgSubmitRe
 		DataHash:     r.DataHash,
 		DataSize:     r.DataSize,
 		Data:         r.Data,
+		DataURI:      r.DataURI,
 	}
 
 	return recordOutput, nil
 }
+
+// QueryRecord gets a record by ID through the record querier
+func QueryRecord(cliCtx context.CLIContext, cdc *codec.Codec, recordID string) (record.MsgSubmitRecord, error) {
+	var submitRecord record.MsgSubmitRecord
+	bz, err := cdc.MarshalJSON(record.QueryRecordParams{RecordID: recordID})
+	if err != nil {
+		return submitRecord, err
+	}
+
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/record/%s", record.QueryRecord), bz)
+	if err != nil {
+		return submitRecord, err
+	}
+
+	err = cdc.UnmarshalJSON(res, &submitRecord)
+	return submitRecord, err
+}
+
+// QueryRecords lists the records of an owner through the record querier
+func QueryRecords(cliCtx context.CLIContext, cdc *codec.Codec, params record.QueryRecordsParams) ([]RecordOutput, error) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/record/%s", record.QueryRecords), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []record.MsgSubmitRecord
+	if err = cdc.UnmarshalJSON(res, &records); err != nil {
+		return nil, err
+	}
+
+	outputs := make([]RecordOutput, 0, len(records))
+	for _, r := range records {
+		output, err := ConvertRecordToRecordOutput(cliCtx, r)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/irisnet/irishub/modules/service"
+)
+
+const (
+	fileScheme = "file://"
+	httpScheme = "http://"
+	tlsScheme  = "https://"
+)
+
+// OutputCache resolves the outputs of OffChainCached responses from their location, verifies them
+// against the output hash on chain and keeps the verified outputs in a local directory
+type OutputCache struct {
+	dir    string
+	client *http.Client
+}
+
+func NewOutputCache(dir string) OutputCache {
+	return OutputCache{
+		dir:    dir,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Resolve returns the output of a response, fetching and verifying it if it is cached off-chain
+func (c OutputCache) Resolve(resp service.SvcResponse) ([]byte, error) {
+	if len(resp.OutputHash) == 0 {
+		return resp.Output, nil
+	}
+
+	if output, err := ioutil.ReadFile(c.path(resp.OutputHash)); err == nil {
+		if bytes.Equal(service.GetOutputHash(output), resp.OutputHash) {
+			return output, nil
+		}
+	}
+
+	output, err := c.Fetch(resp.OutputLocation)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(service.GetOutputHash(output), resp.OutputHash) {
+		return nil, fmt.Errorf("output fetched from %s does not match the output hash %s",
+			resp.OutputLocation, hex.EncodeToString(resp.OutputHash))
+	}
+
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(c.path(resp.OutputHash), output, 0600); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Fetch reads the raw output from a http(s) url or a local file
+func (c OutputCache) Fetch(location string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(location, httpScheme), strings.HasPrefix(location, tlsScheme):
+		res, err := c.client.Get(location)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to fetch output from %s: %s", location, res.Status)
+		}
+		return ioutil.ReadAll(res.Body)
+	case strings.HasPrefix(location, fileScheme):
+		return ioutil.ReadFile(strings.TrimPrefix(location, fileScheme))
+	default:
+		return nil, fmt.Errorf("unsupported output location %s", location)
+	}
+}
+
+func (c OutputCache) path(outputHash []byte) string {
+	return filepath.Join(c.dir, hex.EncodeToString(outputHash))
+}
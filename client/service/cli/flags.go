@@ -28,6 +28,16 @@ const (
 	FlagProfiling          = "profiling"
 	FlagReqChainId         = "request-chain-id"
 	FlagReqId              = "request-id"
+	FlagProviders          = "providers"
+	FlagGroupId            = "group-id"
+	FlagDecryptWith        = "decrypt-with"
+	FlagOutputLocation     = "output-location"
+	FlagFetchOutput        = "fetch-output"
+	FlagTag                = "tag"
+	FlagAuthor             = "author"
+	FlagConsumer           = "consumer"
+	FlagPage               = "page"
+	FlagLimit              = "limit"
 )
 
 var (
@@ -54,6 +64,15 @@ var (
 	FsProfiling          = flag.NewFlagSet("", flag.ContinueOnError)
 	FsReqChainId         = flag.NewFlagSet("", flag.ContinueOnError)
 	FsReqId              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsProviders          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsGroupId            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsDecryptWith        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsOutputLocation     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsFetchOutput        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTag                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAuthor             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsConsumer           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPagination         = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -82,4 +101,15 @@ func init() {
 	FsProfiling.Bool(FlagProfiling, false, "service invocation profiling model, default false")
 	FsReqChainId.String(FlagReqChainId, "", "the ID of the blockchain that the service invocation initiated")
 	FsReqId.String(FlagReqId, "", "the ID of the service invocation")
+	FsProviders.StringSlice(FlagProviders, []string{}, "bech32 encoded providers of a multicast invocation, all available bindings if empty")
+	FsGroupId.String(FlagGroupId, "", "the ID of the multicast service invocation group")
+	FsDecryptWith.String(FlagDecryptWith, "", "name of the local key to decrypt a PubKeyEncryption output with")
+	FsOutputLocation.String(FlagOutputLocation, "", "http(s) or file url of the off-chain output of an OffChainCached method")
+	FsFetchOutput.Bool(FlagFetchOutput, false, "fetch and verify the off-chain output of an OffChainCached response")
+
+	FsTag.String(FlagTag, "", "(optional) filter service definitions by tag")
+	FsAuthor.String(FlagAuthor, "", "(optional) filter service definitions by bech32 encoded author")
+	FsConsumer.String(FlagConsumer, "", "bech32 encoded account initiated the service invocation")
+	FsPagination.Uint64(FlagPage, 1, "page of the query results, starting from 1")
+	FsPagination.Uint64(FlagLimit, 100, "maximum number of the query results per page")
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"os"
 	"fmt"
+	"path/filepath"
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/service"
@@ -10,8 +11,10 @@ import (
 	"github.com/spf13/viper"
 	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/keys"
 	authcmd "github.com/irisnet/irishub/client/auth/cli"
 	cmn "github.com/irisnet/irishub/client/service"
+	"github.com/tendermint/tendermint/libs/cli"
 )
 
 const NULL = "null"
@@ -110,44 +113,231 @@ func GetCmdQuerySvcBind(storeName string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
-func GetCmdQuerySvcBinds(storeName string, cdc *codec.Codec) *cobra.Command {
+func GetCmdQuerySvcDefs(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bindings",
-		Short:   "Query service bindings",
-		Example: "iriscli service bindings --def-chain-id=<chain-id> --service-name=<service name>",
+		Use:     "definitions",
+		Short:   "Query service definitions",
+		Example: "iriscli service definitions [--def-chain-id=<chain-id>] [--tag=<tag>] [--author=<author>] [--page=1] [--limit=100]",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
-				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			name := viper.GetString(FlagServiceName)
-			defChainId := viper.GetString(FlagDefChainID)
+			author, err := getOptionalAddress(FlagAuthor)
+			if err != nil {
+				return err
+			}
 
-			res, err := cliCtx.QuerySubspace(service.GetBindingsSubspaceKey(defChainId, name), storeName)
+			params := service.QueryDefinitionsParams{
+				DefChainID: viper.GetString(FlagDefChainID),
+				Tag:        viper.GetString(FlagTag),
+				Author:     author,
+				Page:       viper.GetUint64(FlagPage),
+				Limit:      viper.GetUint64(FlagLimit),
+			}
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
 
-			var bindings []service.SvcBinding
-			for _, re := range res {
-				var binding service.SvcBinding
-				cdc.MustUnmarshalBinaryLengthPrefixed(re.Value, &binding)
-				bindings = append(bindings, binding)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, service.QueryDefinitions), bz)
+			if err != nil {
+				return err
 			}
 
-			output, err := codec.MarshalJSONIndent(cdc, bindings)
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsDefChainID)
+	cmd.Flags().AddFlagSet(FsTag)
+	cmd.Flags().AddFlagSet(FsAuthor)
+	cmd.Flags().AddFlagSet(FsPagination)
+
+	return cmd
+}
+
+func GetCmdQuerySvcBinds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bindings",
+		Short: "Query service bindings of a service definition or a provider",
+		Example: "iriscli service bindings --def-chain-id=<chain-id> --service-name=<service name> [--page=1] [--limit=100]\n" +
+			"iriscli service bindings --provider=<provider>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			provider, err := getOptionalAddress(FlagProvider)
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(output))
+
+			params := service.QueryBindingsParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				Provider:    provider,
+				Page:        viper.GetUint64(FlagPage),
+				Limit:       viper.GetUint64(FlagLimit),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, service.QueryBindings), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
 			return nil
 		},
 	}
 	cmd.Flags().AddFlagSet(FsDefChainID)
 	cmd.Flags().AddFlagSet(FsServiceName)
+	cmd.Flags().AddFlagSet(FsProvider)
+	cmd.Flags().AddFlagSet(FsPagination)
 
 	return cmd
 }
 
+func GetCmdQuerySvcActiveRequests(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-requests",
+		Short:   "Query active service requests of a consumer or a provider",
+		Example: "iriscli service active-requests [--consumer=<consumer>] [--provider=<provider>] [--page=1] [--limit=100]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consumer, err := getOptionalAddress(FlagConsumer)
+			if err != nil {
+				return err
+			}
+			provider, err := getOptionalAddress(FlagProvider)
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryRequestsParams{
+				Consumer: consumer,
+				Provider: provider,
+				Page:     viper.GetUint64(FlagPage),
+				Limit:    viper.GetUint64(FlagLimit),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, service.QueryRequests), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsConsumer)
+	cmd.Flags().AddFlagSet(FsProvider)
+	cmd.Flags().AddFlagSet(FsPagination)
+
+	return cmd
+}
+
+func GetCmdQuerySvcResponses(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "responses",
+		Short:   "Query service responses of the invocations initiated in a chain",
+		Example: "iriscli service responses --request-chain-id=<req-chain-id> [--consumer=<consumer>] [--provider=<provider>] [--page=1] [--limit=100]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consumer, err := getOptionalAddress(FlagConsumer)
+			if err != nil {
+				return err
+			}
+			provider, err := getOptionalAddress(FlagProvider)
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryResponsesParams{
+				ReqChainID: viper.GetString(FlagReqChainId),
+				Consumer:   consumer,
+				Provider:   provider,
+				Page:       viper.GetUint64(FlagPage),
+				Limit:      viper.GetUint64(FlagLimit),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, service.QueryResponses), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsReqChainId)
+	cmd.Flags().AddFlagSet(FsConsumer)
+	cmd.Flags().AddFlagSet(FsProvider)
+	cmd.Flags().AddFlagSet(FsPagination)
+
+	return cmd
+}
+
+// parse the bech32 address of an optional flag, nil if the flag is not set
+func GetCmdQuerySvcProfiling(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "profiling",
+		Short:   "Query the profiling report of the bindings of a service",
+		Example: "iriscli service profiling --def-chain-id=<chain-id> --service-name=<service name> [--provider=<provider>]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			provider, err := getOptionalAddress(FlagProvider)
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryProfilingParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				Provider:    provider,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, service.QueryProfiling), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsDefChainID)
+	cmd.Flags().AddFlagSet(FsServiceName)
+	cmd.Flags().AddFlagSet(FsProvider)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+
+	return cmd
+}
+
+func getOptionalAddress(flag string) (sdk.AccAddress, error) {
+	addrStr := viper.GetString(flag)
+	if len(addrStr) == 0 {
+		return nil, nil
+	}
+	return sdk.AccAddressFromBech32(addrStr)
+}
+
 func GetCmdQuerySvcRequests(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "requests",
@@ -200,7 +390,7 @@ func GetCmdQuerySvcResponse(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "response",
 		Short:   "Query a service response",
-		Example: "iriscli service response --request-chain-id=<req-chain-id> --request-id=<request-id>",
+		Example: "iriscli service response --request-chain-id=<req-chain-id> --request-id=<request-id> [--fetch-output] [--decrypt-with=<key name>]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
 				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
@@ -224,6 +414,22 @@ func GetCmdQuerySvcResponse(storeName string, cdc *codec.Codec) *cobra.Command {
 				fmt.Println(NULL)
 				return nil
 			}
+
+			if viper.GetBool(FlagFetchOutput) && len(resp.OutputHash) > 0 {
+				resp.Output, err = getOutputCache().Resolve(resp)
+				if err != nil {
+					return err
+				}
+			}
+
+			keyName := viper.GetString(FlagDecryptWith)
+			if len(keyName) > 0 && len(resp.Output) > 0 {
+				resp.Output, err = decryptOutput(keyName, resp.Output)
+				if err != nil {
+					return err
+				}
+			}
+
 			output, err := codec.MarshalJSONIndent(cdc, resp)
 			if err != nil {
 				return err
@@ -234,6 +440,77 @@ func GetCmdQuerySvcResponse(storeName string, cdc *codec.Codec) *cobra.Command {
 	}
 	cmd.Flags().AddFlagSet(FsReqChainId)
 	cmd.Flags().AddFlagSet(FsReqId)
+	cmd.Flags().AddFlagSet(FsDecryptWith)
+	cmd.Flags().AddFlagSet(FsFetchOutput)
+
+	return cmd
+}
+
+// the off-chain outputs are cached under the cli home directory
+func getOutputCache() cmn.OutputCache {
+	return cmn.NewOutputCache(filepath.Join(viper.GetString(cli.HomeFlag), "service-cache"))
+}
+
+// decrypt a PubKeyEncryption output with the private key in the local keybase
+func decryptOutput(keyName string, output []byte) ([]byte, error) {
+	passphrase, err := keys.GetPassphrase(keyName)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := keys.GetKeyBase()
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := kb.ExportPrivateKeyObject(keyName, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return service.DecryptOutput(privKey, output)
+}
+
+func GetCmdQuerySvcGroup(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Short:   "Query a multicast request group and its responses",
+		Example: "iriscli service group --group-id=<group-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			groupId := viper.GetString(FlagGroupId)
+
+			res, err := cliCtx.QueryStore(service.GetRequestGroupKey(groupId), storeName)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("request group [%s] is not existed", groupId)
+			}
+
+			var group service.RequestGroup
+			cdc.MustUnmarshalBinaryLengthPrefixed(res, &group)
+
+			res1, err := cliCtx.QuerySubspace(service.GetGroupResponsesSubspaceKey(groupId), storeName)
+			if err != nil {
+				return err
+			}
+
+			var responses []service.SvcResponse
+			for _, re := range res1 {
+				var response service.SvcResponse
+				cdc.MustUnmarshalBinaryLengthPrefixed(re.Value, &response)
+				responses = append(responses, response)
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, cmn.GroupOutput{Group: group, Responses: responses})
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsGroupId)
 
 	return cmd
 }
@@ -372,12 +372,76 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
-func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
+func GetCmdSvcMulticastCall(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multicall",
+		Short: "Call a service method on multiple providers",
+		Example: "iriscli service multicall --chain-id=<chain-id> --from=<key name> --fee=0.004iris --def-chain-id=<bind-chain-id> " +
+			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --providers=<provider1>,<provider2> --service-fee=1iris --request-data=<req>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			txCtx := context.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			chainId := viper.GetString(client.FlagChainID)
+
+			defChainId := viper.GetString(FlagDefChainID)
+			name := viper.GetString(FlagServiceName)
+			bindChainId := viper.GetString(FlagBindChainID)
+			methodId := int16(viper.GetInt(FlagMethodID))
+
+			var providers []sdk.AccAddress
+			for _, providerStr := range viper.GetStringSlice(FlagProviders) {
+				provider, err := sdk.AccAddressFromBech32(providerStr)
+				if err != nil {
+					return err
+				}
+				providers = append(providers, provider)
+			}
+
+			serviceFeeStr := viper.GetString(FlagServiceFee)
+			serviceFee, err := cliCtx.ParseCoins(serviceFeeStr)
+			if err != nil {
+				return err
+			}
+
+			inputString := viper.GetString(FlagReqData)
+			input, err := hex.DecodeString(inputString)
+			if err != nil {
+				return err
+			}
+
+			profiling := viper.GetBool(FlagProfiling)
+
+			msg := service.NewMsgSvcMulticastRequest(defChainId, name, bindChainId, chainId, fromAddr, providers, methodId, input, serviceFee, profiling)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsDefChainID)
+	cmd.Flags().AddFlagSet(FsServiceName)
+	cmd.Flags().AddFlagSet(FsBindChainID)
+	cmd.Flags().AddFlagSet(FsMethodID)
+	cmd.Flags().AddFlagSet(FsProviders)
+	cmd.Flags().AddFlagSet(FsServiceFee)
+	cmd.Flags().AddFlagSet(FsReqData)
+	cmd.Flags().AddFlagSet(FsProfiling)
+
+	return cmd
+}
+
+func GetCmdSvcRespond(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "respond",
 		Short: "Respond a service method invocation",
 		Example: "iriscli service respond --chain-id=<chain-id> --from=<key name> --fee=0.004iris --request-chain-id=<call-chain-id> " +
-			"--request-id=<request-id> --response-data=<resp>",
+			"--request-id=<request-id> --response-data=<resp> [--output-location=<url>]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
 				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
@@ -403,8 +467,34 @@ func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
 			}
 
 			reqId := viper.GetString(FlagReqId)
+			outputLocation := viper.GetString(FlagOutputLocation)
 
-			msg := service.NewMsgSvcResponse(reqChainId, reqId, fromAddr, output, errMsg)
+			var msg service.MsgSvcResponse
+			if len(outputLocation) > 0 {
+				// the output is kept off-chain as it is, only its hash and location are responded
+				_, method, err := queryRequestMethod(cliCtx, storeName, reqId)
+				if err != nil {
+					return err
+				}
+				if method.OutputPrivacy == service.PubKeyEncryption {
+					return fmt.Errorf("the output of method %d is PubKeyEncryption and can't be cached off-chain, respond with --response-data instead", method.ID)
+				}
+				if len(output) == 0 {
+					output, err = getOutputCache().Fetch(outputLocation)
+					if err != nil {
+						return err
+					}
+				}
+				msg = service.NewMsgSvcCachedResponse(reqChainId, reqId, fromAddr, service.GetOutputHash(output), outputLocation, errMsg)
+			} else {
+				if len(output) > 0 {
+					output, err = encryptOutput(cliCtx, storeName, reqId, output)
+					if err != nil {
+						return err
+					}
+				}
+				msg = service.NewMsgSvcResponse(reqChainId, reqId, fromAddr, output, errMsg)
+			}
 			cliCtx.PrintResponse = true
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
@@ -413,6 +503,7 @@ func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(FsRespData)
 	cmd.Flags().AddFlagSet(FsErrMsg)
 	cmd.Flags().AddFlagSet(FsReqId)
+	cmd.Flags().AddFlagSet(FsOutputLocation)
 
 	return cmd
 }
@@ -462,3 +553,50 @@ func GetCmdSvcWithdrawFees(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+// encrypt the output to the consumer's public key if the called method requires PubKeyEncryption
+// query the active request and the property of its method
+func queryRequestMethod(cliCtx context.CLIContext, storeName string, reqId string) (request service.SvcRequest, method service.MethodProperty, err error) {
+	eHeight, rHeight, counter, err := service.ConvertRequestID(reqId)
+	if err != nil {
+		return request, method, err
+	}
+
+	res, err := cliCtx.QueryStore(service.GetRequestsByExpirationIndexKey(eHeight, rHeight, counter), storeName)
+	if err != nil {
+		return request, method, err
+	}
+	if len(res) == 0 {
+		return request, method, fmt.Errorf("request %s is not active", reqId)
+	}
+	cliCtx.Codec.MustUnmarshalBinaryLengthPrefixed(res, &request)
+
+	res, err = cliCtx.QueryStore(service.GetMethodPropertyKey(request.DefChainID, request.DefName, request.MethodID), storeName)
+	if err != nil {
+		return request, method, err
+	}
+	if len(res) == 0 {
+		return request, method, fmt.Errorf("method %d of service %s is not existed", request.MethodID, request.DefName)
+	}
+	cliCtx.Codec.MustUnmarshalBinaryLengthPrefixed(res, &method)
+	return request, method, nil
+}
+
+func encryptOutput(cliCtx context.CLIContext, storeName string, reqId string, output []byte) ([]byte, error) {
+	request, method, err := queryRequestMethod(cliCtx, storeName, reqId)
+	if err != nil {
+		return nil, err
+	}
+	if method.OutputPrivacy != service.PubKeyEncryption {
+		return output, nil
+	}
+
+	account, err := cliCtx.GetAccount(request.Consumer)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil || account.GetPubKey() == nil {
+		return nil, fmt.Errorf("public key of consumer %s is unknown", request.Consumer.String())
+	}
+	return service.EncryptOutput(account.GetPubKey(), output)
+}
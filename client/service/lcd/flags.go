@@ -9,4 +9,8 @@ const (
 	Provider    = "provider"
 	Consumer    = "consumer"
 	Address     = "address"
+	Tag         = "tag"
+	Author      = "author"
+	Page        = "page"
+	Limit       = "limit"
 )
@@ -29,18 +29,54 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		bindingHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// get definitions filtered by chain, tag and author
+	r.HandleFunc(
+		"/service/definitions",
+		definitionsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// get all bindings of a definition
 	r.HandleFunc(
 		fmt.Sprintf("/service/bindings/{%s}/{%s}", DefChainId, ServiceName),
 		bindingsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// get all bindings of a provider
+	r.HandleFunc(
+		fmt.Sprintf("/service/providers/{%s}/bindings", Provider),
+		providerBindingsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// get all active requests of a consumer
+	r.HandleFunc(
+		fmt.Sprintf("/service/consumers/{%s}/requests", Consumer),
+		activeRequestsHandlerFn(cliCtx, cdc, Consumer),
+	).Methods("GET")
+
+	// get all active requests of a provider
+	r.HandleFunc(
+		fmt.Sprintf("/service/providers/{%s}/requests", Provider),
+		activeRequestsHandlerFn(cliCtx, cdc, Provider),
+	).Methods("GET")
+
+	// get responses of the requests initiated in a chain
+	r.HandleFunc(
+		fmt.Sprintf("/service/responses/{%s}", ReqChainId),
+		responsesHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// get all active requests of a binding
 	r.HandleFunc(
 		fmt.Sprintf("/service/requests/{%s}/{%s}/{%s}/{%s}", DefChainId, ServiceName, BindChainId, Provider),
 		requestsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// get the profiling report of the bindings of a service
+	r.HandleFunc(
+		fmt.Sprintf("/service/profiling/{%s}/{%s}", DefChainId, ServiceName),
+		profilingHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// get a single response
 	r.HandleFunc(
 		fmt.Sprintf("/service/response/{%s}/{%s}", ReqChainId, ReqId),
@@ -128,35 +164,180 @@ func bindingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerF
 	}
 }
 
+func definitionsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		author, ok := parseOptionalAddress(w, r.URL.Query().Get(Author))
+		if !ok {
+			return
+		}
+		page, limit, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := service.QueryDefinitionsParams{
+			DefChainID: r.URL.Query().Get(DefChainId),
+			Tag:        r.URL.Query().Get(Tag),
+			Author:     author,
+			Page:       page,
+			Limit:      limit,
+		}
+		queryWithParams(w, cliCtx, cdc, service.QueryDefinitions, params)
+	}
+}
+
 func bindingsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		defChainId := vars[DefChainId]
-		serviceName := vars[ServiceName]
+		page, limit, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := service.QueryBindingsParams{
+			DefChainID:  vars[DefChainId],
+			ServiceName: vars[ServiceName],
+			Page:        page,
+			Limit:       limit,
+		}
+		queryWithParams(w, cliCtx, cdc, service.QueryBindings, params)
+	}
+}
 
-		res, err := cliCtx.QuerySubspace(service.GetBindingsSubspaceKey(defChainId, serviceName), storeName)
+func providerBindingsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		providerAddr, err := sdk.AccAddressFromBech32(vars[Provider])
 		if err != nil {
-			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
-			utils.WriteErrorResponse(w, http.StatusNoContent, "")
+		page, limit, ok := parsePagination(w, r)
+		if !ok {
 			return
 		}
-		var bindings []service.SvcBinding
-		for _, re := range res {
-			var binding service.SvcBinding
-			cdc.MustUnmarshalBinaryLengthPrefixed(re.Value, &binding)
-			bindings = append(bindings, binding)
+
+		params := service.QueryBindingsParams{
+			Provider: providerAddr,
+			Page:     page,
+			Limit:    limit,
 		}
+		queryWithParams(w, cliCtx, cdc, service.QueryBindings, params)
+	}
+}
 
-		output, err := codec.MarshalJSONIndent(cdc, bindings)
+// the active requests of the consumer or the provider given by the participant path variable
+func activeRequestsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, participant string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		addr, err := sdk.AccAddressFromBech32(vars[participant])
 		if err != nil {
-			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, output, cliCtx.Indent)
+		page, limit, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := service.QueryRequestsParams{
+			Page:  page,
+			Limit: limit,
+		}
+		if participant == Consumer {
+			params.Consumer = addr
+		} else {
+			params.Provider = addr
+		}
+		queryWithParams(w, cliCtx, cdc, service.QueryRequests, params)
+	}
+}
+
+func responsesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		consumer, ok := parseOptionalAddress(w, r.URL.Query().Get(Consumer))
+		if !ok {
+			return
+		}
+		provider, ok := parseOptionalAddress(w, r.URL.Query().Get(Provider))
+		if !ok {
+			return
+		}
+		page, limit, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := service.QueryResponsesParams{
+			ReqChainID: vars[ReqChainId],
+			Consumer:   consumer,
+			Provider:   provider,
+			Page:       page,
+			Limit:      limit,
+		}
+		queryWithParams(w, cliCtx, cdc, service.QueryResponses, params)
+	}
+}
+
+func queryWithParams(w http.ResponseWriter, cliCtx context.CLIContext, cdc *codec.Codec, path string, params interface{}) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, path), bz)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+}
+
+// parse the page and limit query parameters, the querier defaults are used if absent
+func parsePagination(w http.ResponseWriter, r *http.Request) (page, limit uint64, ok bool) {
+	if strPage := r.URL.Query().Get(Page); len(strPage) > 0 {
+		if page, ok = utils.ParseUint64OrReturnBadRequest(w, strPage); !ok {
+			return
+		}
+	}
+	if strLimit := r.URL.Query().Get(Limit); len(strLimit) > 0 {
+		if limit, ok = utils.ParseUint64OrReturnBadRequest(w, strLimit); !ok {
+			return
+		}
+	}
+	return page, limit, true
+}
+
+// the profiling report, filtered by the optional provider query parameter
+func profilingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		provider, ok := parseOptionalAddress(w, r.URL.Query().Get(Provider))
+		if !ok {
+			return
+		}
+
+		params := service.QueryProfilingParams{
+			DefChainID:  vars[DefChainId],
+			ServiceName: vars[ServiceName],
+			Provider:    provider,
+		}
+		queryWithParams(w, cliCtx, cdc, service.QueryProfiling, params)
+	}
+}
+
+func parseOptionalAddress(w http.ResponseWriter, bechAddr string) (sdk.AccAddress, bool) {
+	if len(bechAddr) == 0 {
+		return nil, true
+	}
+	addr, err := sdk.AccAddressFromBech32(bechAddr)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
 	}
+	return addr, true
 }
 
 func requestsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
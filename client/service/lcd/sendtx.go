@@ -430,6 +430,14 @@ func responseAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 		}
 
 		msg := service.NewMsgSvcResponse(req.ReqChainId, req.RequestId, provider, output, errMsg)
+		if len(req.OutputLocation) > 0 {
+			outputHash, err := hex.DecodeString(req.OutputHash)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = service.NewMsgSvcCachedResponse(req.ReqChainId, req.RequestId, provider, outputHash, req.OutputLocation, errMsg)
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -559,12 +567,14 @@ type serviceRequestWithBasic struct {
 }
 
 type serviceResponse struct {
-	BaseTx     context.BaseTx `json:"base_tx"` // basic tx info
-	ReqChainId string         `json:"req_chain_id"`
-	RequestId  string         `json:"request_id"`
-	Data       string         `json:"data"`
-	Provider   string         `json:"provider"`
-	ErrorMsg   string         `json:"error_msg"`
+	BaseTx         context.BaseTx `json:"base_tx"` // basic tx info
+	ReqChainId     string         `json:"req_chain_id"`
+	RequestId      string         `json:"request_id"`
+	Data           string         `json:"data"`
+	Provider       string         `json:"provider"`
+	ErrorMsg       string         `json:"error_msg"`
+	OutputHash     string         `json:"output_hash"`     // hex encoded hash of the off-chain output
+	OutputLocation string         `json:"output_location"` // location of the off-chain output
 }
 
 type basicReq struct {
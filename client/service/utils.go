@@ -14,3 +14,8 @@ type FeesOutput struct {
 	ReturnedFee sdk.Coins `json:"returned_fee"`
 	IncomingFee sdk.Coins `json:"incoming_fee"`
 }
+
+type GroupOutput struct {
+	Group     service.RequestGroup  `json:"group"`
+	Responses []service.SvcResponse `json:"responses"`
+}
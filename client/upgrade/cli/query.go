@@ -99,3 +99,45 @@ func GetCmdQuerySwitch(storeName string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// Command to Get the readiness of the current upgrade proposal
+func GetCmdQueryReadiness(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-readiness",
+		Short:   "query which validators have switched to the new software and whether the upgrade would pass",
+		Example: "iriscli upgrade query-readiness",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryReadiness), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// Command to Get the history of the upgrade attempts
+func GetCmdQueryHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-history",
+		Short:   "query the passed, dropped and aborted upgrade attempts",
+		Example: "iriscli upgrade query-history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryHistory), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/codec"
 	authcmd "github.com/irisnet/irishub/client/auth/cli"
@@ -16,6 +20,8 @@ const (
 	flagProposalID = "proposal-id"
 	flagTitle      = "title"
 	flagVoter      = "voter"
+	flagSoftwareHash = "software-hash"
+	flagBinary       = "binary"
 )
 
 // submit switch msg
@@ -23,7 +29,7 @@ func GetCmdSubmitSwitch(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-switch",
 		Short: "Submit a switch msg for a upgrade propsal",
-		Example: "iriscli upgrade submit-switch --chain-id=<chain-id> --from=<key name> --fee=0.004iris --proposal-id 1 --title <title>",
+		Example: "iriscli upgrade submit-switch --chain-id=<chain-id> --from=<key name> --fee=0.004iris --proposal-id 1 --title <title> --binary $(which iris)",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			title := viper.GetString(flagTitle)
 			proposalID := uint64(viper.GetInt64(flagProposalID))
@@ -41,7 +47,15 @@ func GetCmdSubmitSwitch(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := upgrade.NewMsgSwitch(title, proposalID, from)
+			softwareHash := viper.GetString(flagSoftwareHash)
+			if binary := viper.GetString(flagBinary); len(binary) > 0 {
+				softwareHash, err = hashFile(binary)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := upgrade.NewMsgSwitch(title, proposalID, from, softwareHash)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -55,6 +69,23 @@ func GetCmdSubmitSwitch(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagTitle, "", "title of switch")
 	cmd.Flags().String(flagProposalID, "", "proposalID of upgrade proposal")
+	cmd.Flags().String(flagSoftwareHash, "", "hex encoded sha256 of the software the validator runs")
+	cmd.Flags().String(flagBinary, "", "path of the software binary the validator runs, its sha256 is used as the software hash")
 
 	return cmd
 }
+
+// hashFile returns the hex encoded sha256 of the file at path
+func hashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -172,6 +172,40 @@ func SignStdTx(txCtx context.TxContext, cliCtx context.CLIContext, name string,
 	return txCtx.SignStdTx(name, passphrase, stdTx, appendSig)
 }
 
+// SignStdTxWithSignerAddress signs a StdTx on behalf of the signer addr, the multisig account
+// the key name is part of. The account number and sequence are the ones of addr and only the
+// new signature is kept, for "multisign" to merge it with the others.
+func SignStdTxWithSignerAddress(txCtx context.TxContext, cliCtx context.CLIContext, addr sdk.AccAddress, name string, stdTx auth.StdTx, offline bool) (auth.StdTx, error) {
+	var signedStdTx auth.StdTx
+
+	// Check whether the address is a signer
+	if !isTxSigner(addr, stdTx.GetSigners()) {
+		return signedStdTx, fmt.Errorf("the transaction's signers don't include %s", addr)
+	}
+
+	if !offline && txCtx.AccountNumber == 0 {
+		accNum, err := cliCtx.GetAccountNumber(addr)
+		if err != nil {
+			return signedStdTx, err
+		}
+		txCtx = txCtx.WithAccountNumber(accNum)
+	}
+
+	if !offline && txCtx.Sequence == 0 {
+		accSeq, err := cliCtx.GetAccountSequence(addr)
+		if err != nil {
+			return signedStdTx, err
+		}
+		txCtx = txCtx.WithSequence(accSeq)
+	}
+
+	passphrase, err := keys.GetPassphrase(name)
+	if err != nil {
+		return signedStdTx, err
+	}
+	return txCtx.SignStdTx(name, passphrase, stdTx, false)
+}
+
 // nolint
 // SimulateMsgs simulates the transaction and returns the gas estimate and the adjusted value.
 func simulateMsgs(txCtx context.TxContext, cliCtx context.CLIContext, name string, msgs []sdk.Msg) (estimated, adjusted int64, result sdk.Result, err error) {
@@ -59,6 +59,7 @@ func main() {
 		client.LineBreak,
 		tendermintCmd,
 		server.ExportCmd(ctx, cdc, exportAppStateAndTMValidators),
+		server.MigrateDryRunCmd(ctx, migrateDryRun),
 		client.LineBreak,
 	)
 
@@ -90,3 +91,8 @@ func exportAppStateAndTMValidators(
 	}
 	return gApp.ExportAppStateAndValidators()
 }
+
+func migrateDryRun(logger log.Logger, genDoc tmtypes.GenesisDoc, version int64) (interface{}, error) {
+	gApp := app.NewIrisApp(logger, dbm.NewMemDB(), nil)
+	return gApp.DryRunMigrations(genDoc, version)
+}
@@ -14,6 +14,8 @@ import (
 	recordcmd "github.com/irisnet/irishub/client/record/cli"
 	servicecmd "github.com/irisnet/irishub/client/service/cli"
 	guardiancmd "github.com/irisnet/irishub/client/guardian/cli"
+	assetcmd "github.com/irisnet/irishub/client/asset/cli"
+	arbitrationcmd "github.com/irisnet/irishub/client/arbitration/cli"
 	slashingcmd "github.com/irisnet/irishub/client/slashing/cli"
 	stakecmd "github.com/irisnet/irishub/client/stake/cli"
 	tendermintrpccmd "github.com/irisnet/irishub/client/tendermint/rpc"
@@ -68,11 +70,14 @@ func main() {
 		client.GetCommands(
 			bankcmd.GetCmdQueryCoinType(cdc),
 			bankcmd.GetAccountCmd("acc", cdc, authcmd.GetAccountDecoder(cdc)),
+			bankcmd.GetCmdQuerySupply("bank", cdc),
 		)...)
 	bankCmd.AddCommand(
 		client.PostCommands(
 			bankcmd.SendTxCmd(cdc),
+			bankcmd.BurnTxCmd(cdc),
 			bankcmd.GetSignCommand(cdc, authcmd.GetAccountDecoder(cdc)),
+			bankcmd.GetMultiSignCommand(cdc),
 			bankcmd.GetBroadcastCommand(cdc),
 		)...)
 	rootCmd.AddCommand(
@@ -111,16 +116,20 @@ func main() {
 			govcmd.GetCmdQueryProposals("gov", cdc),
 			govcmd.GetCmdQueryVote("gov", cdc),
 			govcmd.GetCmdQueryVotes("gov", cdc),
+			govcmd.GetCmdQueryVoteChanges("gov", cdc),
 			govcmd.GetCmdQueryDeposit("gov", cdc),
 			govcmd.GetCmdQueryDeposits("gov", cdc),
 			govcmd.GetCmdQueryTally("gov", cdc),
+			govcmd.GetCmdQueryTaxUsages("gov", cdc),
 			govcmd.GetCmdQueryGovConfig("params", cdc),
 			govcmd.GetCmdPullGovConfig("params", cdc),
 		)...)
 	govCmd.AddCommand(
 		client.PostCommands(
 			govcmd.GetCmdSubmitProposal(cdc),
+			govcmd.GetCmdSubmitTaxUsageProposal(cdc),
 			govcmd.GetCmdDeposit(cdc),
+			govcmd.GetCmdCancelProposal(cdc),
 			govcmd.GetCmdVote(cdc),
 		)...)
 	rootCmd.AddCommand(
@@ -170,6 +179,8 @@ func main() {
 		client.GetCommands(
 			upgradecmd.GetInfoCmd("upgrade", cdc),
 			upgradecmd.GetCmdQuerySwitch("upgrade", cdc),
+			upgradecmd.GetCmdQueryReadiness("upgrade", cdc),
+			upgradecmd.GetCmdQueryHistory("upgrade", cdc),
 		)...)
 	upgradeCmd.AddCommand(
 		client.PostCommands(
@@ -187,11 +198,16 @@ func main() {
 	serviceCmd.AddCommand(
 		client.GetCommands(
 			servicecmd.GetCmdQuerySvcDef("service", cdc),
+			servicecmd.GetCmdQuerySvcDefs("service", cdc),
 			servicecmd.GetCmdQuerySvcBind("service", cdc),
 			servicecmd.GetCmdQuerySvcBinds("service", cdc),
 			servicecmd.GetCmdQuerySvcRequests("service", cdc),
+			servicecmd.GetCmdQuerySvcActiveRequests("service", cdc),
 			servicecmd.GetCmdQuerySvcResponse("service", cdc),
+			servicecmd.GetCmdQuerySvcResponses("service", cdc),
+			servicecmd.GetCmdQuerySvcGroup("service", cdc),
 			servicecmd.GetCmdQuerySvcFees("service", cdc),
+			servicecmd.GetCmdQuerySvcProfiling("service", cdc),
 		)...)
 	serviceCmd.AddCommand(client.PostCommands(
 		servicecmd.GetCmdSvcDef(cdc),
@@ -201,7 +217,8 @@ func main() {
 		servicecmd.GetCmdSvcEnable(cdc),
 		servicecmd.GetCmdSvcRefundDeposit(cdc),
 		servicecmd.GetCmdSvcCall(cdc),
-		servicecmd.GetCmdSvcRespond(cdc),
+		servicecmd.GetCmdSvcMulticastCall(cdc),
+		servicecmd.GetCmdSvcRespond("service", cdc),
 		servicecmd.GetCmdSvcRefundFees(cdc),
 		servicecmd.GetCmdSvcWithdrawFees(cdc),
 	)...)
@@ -224,11 +241,57 @@ func main() {
 	guardianCmd.AddCommand(
 		client.PostCommands(
 			guardiancmd.GetCmdCreateProfiler(cdc),
+			guardiancmd.GetCmdDeleteProfiler(cdc),
+			guardiancmd.GetCmdAddTrustee(cdc),
+			guardiancmd.GetCmdDeleteTrustee(cdc),
 		)...)
 	rootCmd.AddCommand(
 		guardianCmd,
 	)
 
+	//add asset command
+	assetCmd := &cobra.Command{
+		Use:   "asset",
+		Short: "Asset subcommands",
+	}
+	assetCmd.AddCommand(
+		client.GetCommands(
+			assetcmd.GetCmdQueryAsset("asset", cdc),
+			assetcmd.GetCmdQueryAssets("asset", cdc),
+		)...)
+
+	assetCmd.AddCommand(
+		client.PostCommands(
+			assetcmd.GetCmdIssueAsset(cdc),
+			assetcmd.GetCmdMintAsset(cdc),
+			assetcmd.GetCmdBurnAsset(cdc),
+			assetcmd.GetCmdTransferAssetOwnership(cdc),
+			assetcmd.GetCmdFreezeAssetMint(cdc),
+		)...)
+	rootCmd.AddCommand(
+		assetCmd,
+	)
+
+	//add arbitration command
+	arbitrationCmd := &cobra.Command{
+		Use:   "arbitration",
+		Short: "Arbitration subcommands",
+	}
+	arbitrationCmd.AddCommand(
+		client.GetCommands(
+			arbitrationcmd.GetCmdQueryComplaint("arbitration", cdc),
+			arbitrationcmd.GetCmdQueryComplaints("arbitration", cdc),
+		)...)
+
+	arbitrationCmd.AddCommand(
+		client.PostCommands(
+			arbitrationcmd.GetCmdComplain(cdc),
+			arbitrationcmd.GetCmdArbitrate(cdc),
+		)...)
+	rootCmd.AddCommand(
+		arbitrationCmd,
+	)
+
 	//add record command
 	recordCmd := &cobra.Command{
 		Use:   "record",
@@ -238,12 +301,17 @@ func main() {
 	recordCmd.AddCommand(
 		client.GetCommands(
 			recordcmd.GetCmdQureyRecord("record", cdc),
+			recordcmd.GetCmdQueryRecords("record", cdc),
+			recordcmd.GetCmdQueryRecordHistory("record", cdc),
 			recordcmd.GetCmdDownload("record", cdc),
 		)...)
 
 	recordCmd.AddCommand(
 		client.PostCommands(
 			recordcmd.GetCmdSubmitRecord("record", cdc),
+			recordcmd.GetCmdAmendRecord(cdc),
+			recordcmd.GetCmdSupersedeRecord("record", cdc),
+			recordcmd.GetCmdRevokeRecord(cdc),
 		)...)
 	rootCmd.AddCommand(
 		recordCmd,
@@ -17,6 +17,12 @@ import (
 	"github.com/irisnet/irishub/client/context"
 )
 
+const (
+	flagVestingAmt   = "vesting-amount"
+	flagVestingStart = "vesting-start-time"
+	flagVestingEnd   = "vesting-end-time"
+)
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command
 func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -41,6 +47,24 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 			coins.Sort()
+
+			var vestingAmt sdk.Coins
+			vestingStart := viper.GetInt64(flagVestingStart)
+			vestingEnd := viper.GetInt64(flagVestingEnd)
+			if viper.GetString(flagVestingAmt) != "" {
+				vestingAmt, err = cliCtx.ParseCoins(viper.GetString(flagVestingAmt))
+				if err != nil {
+					return err
+				}
+				vestingAmt.Sort()
+				if err = auth.ValidateVestingSchedule(vestingStart, vestingEnd); err != nil {
+					return err
+				}
+				if !coins.Minus(vestingAmt).IsNotNegative() {
+					return fmt.Errorf("vesting amount %s exceeds the coins of the account %s", vestingAmt, coins)
+				}
+			}
+
 			genFile := config.GenesisFile()
 			if !common.FileExists(genFile) {
 				return fmt.Errorf("%s does not exist, run `iris init` first", genFile)
@@ -55,7 +79,17 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 			}
 			acc := auth.NewBaseAccountWithAddress(addr)
 			acc.Coins = coins
-			genesisState.Accounts = append(genesisState.Accounts, app.NewGenesisFileAccount(&acc))
+			genAcc := app.NewGenesisFileAccount(&acc)
+			if !vestingAmt.IsZero() {
+				genAcc = app.NewGenesisFileAccountI(app.GenesisAccount{
+					Address:         addr,
+					Coins:           coins,
+					OriginalVesting: vestingAmt,
+					StartTime:       vestingStart,
+					EndTime:         vestingEnd,
+				})
+			}
+			genesisState.Accounts = append(genesisState.Accounts, genAcc)
 			appStateJSON, err := cdc.MarshalJSON(genesisState)
 			if err != nil {
 				return err
@@ -64,5 +98,8 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 		},
 	}
 	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins locked in a vesting account")
+	cmd.Flags().Int64(flagVestingStart, 0, "unix time in seconds the continuous vesting starts, zero for a delayed vesting")
+	cmd.Flags().Int64(flagVestingEnd, 0, "unix time in seconds the vesting ends")
 	return cmd
 }
@@ -0,0 +1,119 @@
+package arbitration
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/pkg/errors"
+)
+
+// Complaint - a consumer's dispute against a service response
+type Complaint struct {
+	DefChainID    string          `json:"def_chain_id"`
+	DefName       string          `json:"def_name"`
+	BindChainID   string          `json:"bind_chain_id"`
+	ReqChainID    string          `json:"req_chain_id"`
+	RequestID     string          `json:"request_id"`
+	Consumer      sdk.AccAddress  `json:"consumer"`
+	Provider      sdk.AccAddress  `json:"provider"`
+	Reason        string          `json:"reason"`
+	LockedDeposit sdk.Coins       `json:"locked_deposit"` // part of the binding deposit locked until the complaint is closed
+	Status        ComplaintStatus `json:"status"`
+	ComplainTime  time.Time       `json:"complain_time"`
+	Deadline      time.Time       `json:"deadline"` // the arbitrator must rule before this time
+	Arbitrator    sdk.AccAddress  `json:"arbitrator"`
+	Ruling        string          `json:"ruling"`
+	CloseHeight   int64           `json:"close_height"`
+}
+
+func NewComplaint(defChainID, defName, bindChainID, reqChainID, requestID string, consumer, provider sdk.AccAddress,
+	reason string, lockedDeposit sdk.Coins, complainTime, deadline time.Time) Complaint {
+	return Complaint{
+		DefChainID:    defChainID,
+		DefName:       defName,
+		BindChainID:   bindChainID,
+		ReqChainID:    reqChainID,
+		RequestID:     requestID,
+		Consumer:      consumer,
+		Provider:      provider,
+		Reason:        reason,
+		LockedDeposit: lockedDeposit,
+		Status:        StatusPending,
+		ComplainTime:  complainTime,
+		Deadline:      deadline,
+	}
+}
+
+type ComplaintStatus byte
+
+const (
+	StatusPending  ComplaintStatus = 0x01 // waiting for an arbitrator ruling
+	StatusRefunded ComplaintStatus = 0x02 // ruled in favor of the consumer, the locked deposit refunds the service fee
+	StatusRejected ComplaintStatus = 0x03 // ruled in favor of the provider, the locked deposit is released
+	StatusExpired  ComplaintStatus = 0x04 // no ruling within the arbitration time limit, the locked deposit is released
+)
+
+// String to ComplaintStatus byte, Returns ff if invalid.
+func ComplaintStatusFromString(str string) (ComplaintStatus, error) {
+	switch str {
+	case "Pending":
+		return StatusPending, nil
+	case "Refunded":
+		return StatusRefunded, nil
+	case "Rejected":
+		return StatusRejected, nil
+	case "Expired":
+		return StatusExpired, nil
+	default:
+		return ComplaintStatus(0xff), errors.Errorf("'%s' is not a valid complaint status", str)
+	}
+}
+
+// For Printf / Sprintf, returns bech32 when using %s
+func (status ComplaintStatus) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", status.String())))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(status))))
+	}
+}
+
+// Turns ComplaintStatus byte to String
+func (status ComplaintStatus) String() string {
+	switch status {
+	case StatusPending:
+		return "Pending"
+	case StatusRefunded:
+		return "Refunded"
+	case StatusRejected:
+		return "Rejected"
+	case StatusExpired:
+		return "Expired"
+	default:
+		return ""
+	}
+}
+
+// Marshals to JSON using string
+func (status ComplaintStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// Unmarshals from JSON assuming Bech32 encoding
+func (status *ComplaintStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := ComplaintStatusFromString(s)
+	if err != nil {
+		return err
+	}
+	*status = bz2
+	return nil
+}
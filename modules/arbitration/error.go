@@ -0,0 +1,68 @@
+package arbitration
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 26
+
+	CodeComplaintExists        sdk.CodeType = 100
+	CodeComplaintNotExists     sdk.CodeType = 101
+	CodeComplaintNotPending    sdk.CodeType = 102
+	CodeResponseNotExists      sdk.CodeType = 103
+	CodeNotMatchingConsumer    sdk.CodeType = 104
+	CodeComplaintRetrospectEnd sdk.CodeType = 105
+	CodeNotArbitrator          sdk.CodeType = 106
+	CodeInvalidReqId           sdk.CodeType = 107
+	CodeInvalidReason          sdk.CodeType = 108
+	CodeInvalidChainId         sdk.CodeType = 109
+	CodeInvalidServiceName     sdk.CodeType = 110
+)
+
+func ErrComplaintExists(codespace sdk.CodespaceType, reqChainID, requestID string) sdk.Error {
+	return sdk.NewError(codespace, CodeComplaintExists, fmt.Sprintf("complaint for request [%s] in %s already exists", requestID, reqChainID))
+}
+
+func ErrComplaintNotExists(codespace sdk.CodespaceType, reqChainID, requestID string) sdk.Error {
+	return sdk.NewError(codespace, CodeComplaintNotExists, fmt.Sprintf("complaint for request [%s] in %s is not existed", requestID, reqChainID))
+}
+
+func ErrComplaintNotPending(codespace sdk.CodespaceType, status ComplaintStatus) sdk.Error {
+	return sdk.NewError(codespace, CodeComplaintNotPending, fmt.Sprintf("complaint is already closed with status %s", status))
+}
+
+func ErrResponseNotExists(codespace sdk.CodespaceType, requestID string) sdk.Error {
+	return sdk.NewError(codespace, CodeResponseNotExists, fmt.Sprintf("there is no response for request [%s]", requestID))
+}
+
+func ErrNotMatchingConsumer(codespace sdk.CodespaceType, consumer sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotMatchingConsumer, fmt.Sprintf("[%s] is not a matching consumer", consumer.String()))
+}
+
+func ErrComplaintRetrospectEnd(codespace sdk.CodespaceType, endTime time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeComplaintRetrospectEnd, fmt.Sprintf("can not complain after %s", endTime.Format("2006-01-02 15:04:05")))
+}
+
+func ErrNotArbitrator(codespace sdk.CodespaceType, arbitrator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotArbitrator, fmt.Sprintf("[%s] is not a trustee", arbitrator.String()))
+}
+
+func ErrInvalidReqId(codespace sdk.CodespaceType, reqId string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReqId, fmt.Sprintf("invalid request id [%s]", reqId))
+}
+
+func ErrInvalidReason(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReason, fmt.Sprintf("reason is empty or longer than %d", MaxReasonLength))
+}
+
+func ErrInvalidChainId(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChainId, fmt.Sprintf("chain id is empty"))
+}
+
+func ErrInvalidServiceName(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidServiceName, fmt.Sprintf("invalid service name %s", name))
+}
@@ -0,0 +1,76 @@
+package arbitration
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/modules/arbitration/tags"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// handle all "arbitration" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgComplain:
+			return handleMsgComplain(ctx, k, msg)
+		case MsgArbitrate:
+			return handleMsgArbitrate(ctx, k, msg)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in arbitration module").Result()
+		}
+	}
+}
+
+func handleMsgComplain(ctx sdk.Context, k Keeper, msg MsgComplain) sdk.Result {
+	complaint, err := k.Complain(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionComplain,
+		tags.RequestID, []byte(complaint.RequestID),
+		tags.Provider, []byte(complaint.Provider.String()),
+		tags.Consumer, []byte(complaint.Consumer.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgArbitrate(ctx sdk.Context, k Keeper, msg MsgArbitrate) sdk.Result {
+	complaint, err := k.Arbitrate(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionArbitrate,
+		tags.RequestID, []byte(complaint.RequestID),
+		tags.Arbitrator, []byte(complaint.Arbitrator.String()),
+		tags.Status, []byte(complaint.Status.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+// Called every block, close complaints which are not ruled within the arbitration time limit
+func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
+	logger := ctx.Logger().With("module", "arbitration")
+	resTags = sdk.NewTags()
+
+	deadlineIterator := keeper.ComplaintDeadlineQueueIterator(ctx, ctx.BlockHeader().Time)
+	for ; deadlineIterator.Valid(); deadlineIterator.Next() {
+		var complaint Complaint
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(deadlineIterator.Value(), &complaint)
+
+		keeper.ExpireComplaint(ctx, complaint)
+
+		resTags = resTags.AppendTag(tags.Action, tags.ActionComplaintExpired)
+		resTags = resTags.AppendTag(tags.RequestID, []byte(complaint.RequestID))
+		logger.Info(fmt.Sprintf("complaint for request %s from %s expired without ruling",
+			complaint.RequestID, complaint.Consumer))
+	}
+	deadlineIterator.Close()
+
+	return resTags
+}
@@ -0,0 +1,171 @@
+package arbitration
+
+import (
+	"time"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/arbitration/params"
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/service"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	sk       service.Keeper
+	gk       guardian.Keeper
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk service.Keeper, gk guardian.Keeper, codespace sdk.CodespaceType) Keeper {
+	keeper := Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		sk:        sk,
+		gk:        gk,
+		codespace: codespace,
+	}
+	return keeper
+}
+
+// return the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// File a complaint against a service response, the service fee of the request is locked from the binding deposit
+func (k Keeper) Complain(ctx sdk.Context, msg MsgComplain) (Complaint, sdk.Error) {
+	var complaint Complaint
+	if _, found := k.GetComplaint(ctx, msg.ReqChainID, msg.RequestID); found {
+		return complaint, ErrComplaintExists(k.Codespace(), msg.ReqChainID, msg.RequestID)
+	}
+
+	eHeight, rHeight, counter, err := service.ConvertRequestID(msg.RequestID)
+	if err != nil {
+		return complaint, ErrInvalidReqId(k.Codespace(), msg.RequestID)
+	}
+
+	response, found := k.sk.GetResponse(ctx, msg.ReqChainID, eHeight, rHeight, counter)
+	if !found {
+		return complaint, ErrResponseNotExists(k.Codespace(), msg.RequestID)
+	}
+	if !response.Consumer.Equals(msg.Consumer) {
+		return complaint, ErrNotMatchingConsumer(k.Codespace(), msg.Consumer)
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	retrospectEnd := response.ResponseTime.Add(arbitrationparams.GetComplaintRetrospect(ctx))
+	if blockTime.After(retrospectEnd) {
+		return complaint, ErrComplaintRetrospectEnd(k.Codespace(), retrospectEnd)
+	}
+
+	request, found := k.sk.GetRequest(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, response.Provider, rHeight, counter)
+	if !found || request.ReqChainID != msg.ReqChainID {
+		return complaint, ErrResponseNotExists(k.Codespace(), msg.RequestID)
+	}
+
+	sdkErr := k.sk.LockDeposit(ctx, request.DefChainID, request.DefName, request.BindChainID, request.Provider, request.ServiceFee)
+	if sdkErr != nil {
+		return complaint, sdkErr
+	}
+
+	deadline := blockTime.Add(arbitrationparams.GetArbitrationTimelimit(ctx))
+	complaint = NewComplaint(request.DefChainID, request.DefName, request.BindChainID, msg.ReqChainID, msg.RequestID,
+		msg.Consumer, request.Provider, msg.Reason, request.ServiceFee, blockTime, deadline)
+
+	k.SetComplaint(ctx, complaint)
+	k.InsertComplaintDeadlineQueue(ctx, complaint)
+	return complaint, nil
+}
+
+// Rule on a pending complaint, only a trustee can arbitrate
+func (k Keeper) Arbitrate(ctx sdk.Context, msg MsgArbitrate) (Complaint, sdk.Error) {
+	complaint, found := k.GetComplaint(ctx, msg.ReqChainID, msg.RequestID)
+	if !found {
+		return complaint, ErrComplaintNotExists(k.Codespace(), msg.ReqChainID, msg.RequestID)
+	}
+	if complaint.Status != StatusPending {
+		return complaint, ErrComplaintNotPending(k.Codespace(), complaint.Status)
+	}
+	if _, found := k.gk.GetTrustee(ctx, msg.Arbitrator); !found {
+		return complaint, ErrNotArbitrator(k.Codespace(), msg.Arbitrator)
+	}
+
+	if msg.Refund {
+		// the locked deposit refunds the service fee to the consumer
+		k.sk.AddReturnFee(ctx, complaint.Consumer, complaint.LockedDeposit)
+		complaint.Status = StatusRefunded
+	} else {
+		err := k.sk.ReleaseDeposit(ctx, complaint.DefChainID, complaint.DefName, complaint.BindChainID, complaint.Provider, complaint.LockedDeposit)
+		if err != nil {
+			return complaint, err
+		}
+		complaint.Status = StatusRejected
+	}
+
+	k.RemoveFromComplaintDeadlineQueue(ctx, complaint)
+	complaint.Arbitrator = msg.Arbitrator
+	complaint.Ruling = msg.Ruling
+	complaint.CloseHeight = ctx.BlockHeight()
+	k.SetComplaint(ctx, complaint)
+	return complaint, nil
+}
+
+// Close a complaint that was not ruled within the arbitration time limit, the locked deposit is released
+func (k Keeper) ExpireComplaint(ctx sdk.Context, complaint Complaint) {
+	err := k.sk.ReleaseDeposit(ctx, complaint.DefChainID, complaint.DefName, complaint.BindChainID, complaint.Provider, complaint.LockedDeposit)
+	if err != nil {
+		panic(err)
+	}
+
+	k.RemoveFromComplaintDeadlineQueue(ctx, complaint)
+	complaint.Status = StatusExpired
+	complaint.CloseHeight = ctx.BlockHeight()
+	k.SetComplaint(ctx, complaint)
+}
+
+//__________________________________________________________________________
+
+func (k Keeper) SetComplaint(ctx sdk.Context, complaint Complaint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(complaint)
+	store.Set(GetComplaintKey(complaint.ReqChainID, complaint.RequestID), bz)
+}
+
+func (k Keeper) GetComplaint(ctx sdk.Context, reqChainID, requestID string) (complaint Complaint, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetComplaintKey(reqChainID, requestID))
+	if bz == nil {
+		return complaint, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &complaint)
+	return complaint, true
+}
+
+// Gets all complaints
+func (k Keeper) GetComplaints(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetComplaintsSubspaceKey())
+}
+
+//__________________________________________________________________________
+
+// Returns an iterator for all the pending complaints whose deadline is by endTime
+func (k Keeper) ComplaintDeadlineQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(complaintsByDeadlineKey, sdk.PrefixEndBytes(GetComplaintsByDeadlinePrefix(endTime)))
+}
+
+func (k Keeper) InsertComplaintDeadlineQueue(ctx sdk.Context, complaint Complaint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(complaint)
+	store.Set(GetComplaintsByDeadlineKey(complaint.Deadline, complaint.ReqChainID, complaint.RequestID), bz)
+}
+
+func (k Keeper) RemoveFromComplaintDeadlineQueue(ctx sdk.Context, complaint Complaint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetComplaintsByDeadlineKey(complaint.Deadline, complaint.ReqChainID, complaint.RequestID))
+}
@@ -0,0 +1,51 @@
+package arbitration
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var (
+	// the separator for string key
+	emptyByte = []byte{0x00}
+
+	// Keys for store prefixes
+	complaintKey            = []byte{0x01}
+	complaintsByDeadlineKey = []byte{0x02}
+)
+
+func GetComplaintKey(reqChainID, requestID string) []byte {
+	return append(complaintKey, getStringsKey([]string{reqChainID, requestID})...)
+}
+
+// Key for getting all complaints from the store
+func GetComplaintsSubspaceKey() []byte {
+	return complaintKey
+}
+
+// get the deadline prefix for all complaints to be ruled by the given time
+func GetComplaintsByDeadlinePrefix(deadline time.Time) []byte {
+	return append(complaintsByDeadlineKey, sdk.FormatTimeBytes(deadline)...)
+}
+
+func GetComplaintsByDeadlineKey(deadline time.Time, reqChainID, requestID string) []byte {
+	return bytes.Join([][]byte{
+		GetComplaintsByDeadlinePrefix(deadline),
+		getStringsKey([]string{reqChainID, requestID}),
+	}, emptyByte)
+}
+
+func getStringsKey(ss []string) (result []byte) {
+	for _, s := range ss {
+		result = append(append(
+			result,
+			[]byte(s)...),
+			emptyByte...)
+	}
+	if len(result) > 0 {
+		return result[0 : len(result)-1]
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package arbitration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/service"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// setup a service binding with a responded request, returns the request
+func setupResponse(t *testing.T, ctx sdk.Context, sk service.Keeper, provider, consumer sdk.AccAddress) service.SvcRequest {
+	svcDef := service.NewSvcDef("myService", "testnet", "the service for unit test", []string{"test"},
+		provider, "unit test author", idlContent)
+	sk.AddServiceDefinition(ctx, svcDef)
+	require.Nil(t, sk.AddMethods(ctx, svcDef))
+
+	svcBinding := service.NewSvcBinding(ctx, "testnet", "myService", "testnet", provider, service.Global,
+		sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{sdk.NewCoin("iris", sdk.NewInt(1))},
+		service.Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	err, _ := sk.AddServiceBinding(ctx, svcBinding)
+	require.Nil(t, err)
+
+	req := service.NewSvcRequest("testnet", "myService", "testnet", "testnet", consumer, provider, 1,
+		[]byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	req, err = sk.AddRequest(ctx, req)
+	require.Nil(t, err)
+
+	resp := service.NewSvcResponse("testnet", req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter,
+		provider, consumer, []byte("1234"), nil)
+	resp.ResponseTime = ctx.BlockHeader().Time
+	sk.AddResponse(ctx, resp)
+	return req
+}
+
+func TestKeeper_Complain_Arbitrate(t *testing.T) {
+	mapp, keeper, sk, gk, addrs, _, _ := getMockApp(t, 3)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	provider, consumer, trustee := addrs[0], addrs[1], addrs[2]
+	mapp.BankKeeper.AddCoins(ctx, provider, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))})
+	mapp.BankKeeper.AddCoins(ctx, consumer, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))})
+	gk.AddTrustee(ctx, guardian.NewTrustee(trustee))
+
+	req := setupResponse(t, ctx, sk, provider, consumer)
+
+	// only the consumer can complain
+	msg := NewMsgComplain("testnet", "myService", "testnet", "testnet", req.RequestID(), provider, "wrong output")
+	_, err := keeper.Complain(ctx, msg)
+	require.NotNil(t, err)
+
+	msg = NewMsgComplain("testnet", "myService", "testnet", "testnet", req.RequestID(), consumer, "wrong output")
+	complaint, err := keeper.Complain(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, StatusPending, complaint.Status)
+	require.True(t, complaint.LockedDeposit.IsEqual(req.ServiceFee))
+
+	binding, _ := sk.GetServiceBinding(ctx, "testnet", "myService", "testnet", provider)
+	require.True(t, binding.Deposit.IsEqual(sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(999))}))
+
+	// a complaint can not be filed twice
+	_, err = keeper.Complain(ctx, msg)
+	require.NotNil(t, err)
+
+	// only a trustee can arbitrate
+	_, err = keeper.Arbitrate(ctx, NewMsgArbitrate("testnet", req.RequestID(), consumer, true, "refund"))
+	require.NotNil(t, err)
+
+	complaint, err = keeper.Arbitrate(ctx, NewMsgArbitrate("testnet", req.RequestID(), trustee, true, "refund"))
+	require.Nil(t, err)
+	require.Equal(t, StatusRefunded, complaint.Status)
+
+	fee, found := sk.GetReturnFee(ctx, consumer)
+	require.True(t, found)
+	require.True(t, fee.Coins.IsEqual(req.ServiceFee))
+
+	_, err = keeper.Arbitrate(ctx, NewMsgArbitrate("testnet", req.RequestID(), trustee, false, "release"))
+	require.NotNil(t, err)
+}
+
+func TestKeeper_ExpireComplaint(t *testing.T) {
+	mapp, keeper, sk, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	blockTime := time.Now()
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: blockTime})
+
+	provider, consumer := addrs[0], addrs[1]
+	mapp.BankKeeper.AddCoins(ctx, provider, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))})
+	mapp.BankKeeper.AddCoins(ctx, consumer, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))})
+
+	req := setupResponse(t, ctx, sk, provider, consumer)
+	msg := NewMsgComplain("testnet", "myService", "testnet", "testnet", req.RequestID(), consumer, "no output")
+	complaint, err := keeper.Complain(ctx, msg)
+	require.Nil(t, err)
+
+	ctx = ctx.WithBlockTime(complaint.Deadline)
+	EndBlocker(ctx, keeper)
+
+	complaint, found := keeper.GetComplaint(ctx, "testnet", req.RequestID())
+	require.True(t, found)
+	require.Equal(t, StatusExpired, complaint.Status)
+
+	binding, _ := sk.GetServiceBinding(ctx, "testnet", "myService", "testnet", provider)
+	require.True(t, binding.Deposit.IsEqual(sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}))
+}
@@ -0,0 +1,145 @@
+package arbitration
+
+import (
+	"regexp"
+
+	"github.com/irisnet/irishub/modules/service"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const (
+	// name to idetify transaction types
+	MsgType = "arbitration"
+
+	MaxReasonLength = 1024
+)
+
+var _ sdk.Msg = MsgComplain{}
+var _ sdk.Msg = MsgArbitrate{}
+
+//______________________________________________________________________
+
+// MsgComplain - struct for a consumer complaining against a service response
+type MsgComplain struct {
+	DefChainID  string         `json:"def_chain_id"`
+	DefName     string         `json:"def_name"`
+	BindChainID string         `json:"bind_chain_id"`
+	ReqChainID  string         `json:"req_chain_id"`
+	RequestID   string         `json:"request_id"`
+	Consumer    sdk.AccAddress `json:"consumer"`
+	Reason      string         `json:"reason"`
+}
+
+func NewMsgComplain(defChainID, defName, bindChainID, reqChainID, requestID string, consumer sdk.AccAddress, reason string) MsgComplain {
+	return MsgComplain{
+		DefChainID:  defChainID,
+		DefName:     defName,
+		BindChainID: bindChainID,
+		ReqChainID:  reqChainID,
+		RequestID:   requestID,
+		Consumer:    consumer,
+		Reason:      reason,
+	}
+}
+
+func (msg MsgComplain) Route() string { return MsgType }
+func (msg MsgComplain) Type() string  { return "arbitration complain" }
+
+func (msg MsgComplain) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgComplain) ValidateBasic() sdk.Error {
+	if len(msg.DefChainID) == 0 || len(msg.BindChainID) == 0 || len(msg.ReqChainID) == 0 {
+		return ErrInvalidChainId(DefaultCodespace)
+	}
+	if !validServiceName(msg.DefName) {
+		return ErrInvalidServiceName(DefaultCodespace, msg.DefName)
+	}
+	if _, _, _, err := service.ConvertRequestID(msg.RequestID); err != nil {
+		return ErrInvalidReqId(DefaultCodespace, msg.RequestID)
+	}
+	if len(msg.Consumer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Consumer.String())
+	}
+	if !validReason(msg.Reason) {
+		return ErrInvalidReason(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgComplain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
+// MsgArbitrate - struct for an arbitrator ruling on a complaint
+type MsgArbitrate struct {
+	ReqChainID string         `json:"req_chain_id"`
+	RequestID  string         `json:"request_id"`
+	Arbitrator sdk.AccAddress `json:"arbitrator"`
+	Refund     bool           `json:"refund"` // true rules for the consumer, false rules for the provider
+	Ruling     string         `json:"ruling"`
+}
+
+func NewMsgArbitrate(reqChainID, requestID string, arbitrator sdk.AccAddress, refund bool, ruling string) MsgArbitrate {
+	return MsgArbitrate{
+		ReqChainID: reqChainID,
+		RequestID:  requestID,
+		Arbitrator: arbitrator,
+		Refund:     refund,
+		Ruling:     ruling,
+	}
+}
+
+func (msg MsgArbitrate) Route() string { return MsgType }
+func (msg MsgArbitrate) Type() string  { return "arbitration arbitrate" }
+
+func (msg MsgArbitrate) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgArbitrate) ValidateBasic() sdk.Error {
+	if len(msg.ReqChainID) == 0 {
+		return ErrInvalidChainId(DefaultCodespace)
+	}
+	if _, _, _, err := service.ConvertRequestID(msg.RequestID); err != nil {
+		return ErrInvalidReqId(DefaultCodespace, msg.RequestID)
+	}
+	if len(msg.Arbitrator) == 0 {
+		return sdk.ErrInvalidAddress(msg.Arbitrator.String())
+	}
+	if !validReason(msg.Ruling) {
+		return ErrInvalidReason(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgArbitrate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Arbitrator}
+}
+
+//______________________________________________________________________
+
+func validServiceName(name string) bool {
+	if len(name) == 0 || len(name) > 128 {
+		return false
+	}
+
+	// Must contain alphanumeric characters, _ and - only
+	reg := regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+	return !reg.Match([]byte(name))
+}
+
+func validReason(reason string) bool {
+	return len(reason) > 0 && len(reason) <= MaxReasonLength
+}
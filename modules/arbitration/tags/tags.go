@@ -0,0 +1,19 @@
+package tags
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var (
+	ActionComplain         = []byte("arbitration-complain")
+	ActionArbitrate        = []byte("arbitration-arbitrate")
+	ActionComplaintExpired = []byte("arbitration-complaint-expiration")
+
+	Action = sdk.TagAction
+
+	Provider   = "provider"
+	Consumer   = "consumer"
+	RequestID  = "request-id"
+	Arbitrator = "arbitrator"
+	Status     = "status"
+)
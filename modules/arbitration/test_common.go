@@ -0,0 +1,85 @@
+package arbitration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/service"
+	"github.com/irisnet/irishub/simulation/mock"
+	"github.com/irisnet/irishub/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// initialize the mock application for this module
+func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, service.Keeper, guardian.Keeper, []sdk.AccAddress, []crypto.PubKey, []crypto.PrivKey) {
+	mapp := mock.NewApp()
+
+	service.RegisterCodec(mapp.Cdc)
+	guardian.RegisterCodec(mapp.Cdc)
+	RegisterCodec(mapp.Cdc)
+
+	keyService := sdk.NewKVStoreKey("service")
+	keyGuardian := sdk.NewKVStoreKey("guardian")
+	keyArbitration := sdk.NewKVStoreKey("arbitration")
+	keyDistr := sdk.NewKVStoreKey("distr")
+
+	ck := bank.NewBaseKeeper(mapp.Cdc, mapp.KeyBank, mapp.AccountKeeper)
+	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, mapp.ParamsKeeper.Subspace(distribution.DefaultParamspace),
+		ck, nil, mapp.FeeCollectionKeeper, distribution.DefaultCodespace)
+	gk := guardian.NewKeeper(mapp.Cdc, keyGuardian, guardian.DefaultCodespace)
+	sk := service.NewKeeper(mapp.Cdc, keyService, ck, dk, gk, service.DefaultCodespace)
+	ak := NewKeeper(mapp.Cdc, keyArbitration, sk, gk, DefaultCodespace)
+
+	mapp.Router().AddRoute("arbitration", []*sdk.KVStoreKey{keyArbitration, keyService, keyGuardian}, NewHandler(ak))
+
+	mapp.SetInitChainer(getInitChainer(mapp, dk))
+
+	require.NoError(t, mapp.CompleteSetup(keyService, keyGuardian, keyArbitration, keyDistr))
+
+	coin, _ := types.NewDefaultCoinType("iris").ConvertToMinCoin(fmt.Sprintf("%d%s", 1042, "iris"))
+	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
+
+	mock.SetGenesis(mapp, genAccs)
+
+	return mapp, ak, sk, gk, addrs, pubKeys, privKeys
+}
+
+// service and arbitration initchainer
+func getInitChainer(mapp *mock.App, dk distribution.Keeper) sdk.InitChainer {
+	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+		distribution.InitGenesis(ctx, dk, distribution.DefaultGenesisState())
+		service.InitGenesis(ctx, service.DefaultGenesisState())
+		InitGenesis(ctx, DefaultGenesisStateForTest())
+		return abci.ResponseInitChain{}
+	}
+}
+
+const idlContent = `
+	syntax = "proto3";
+
+	// The greeting service definition.
+	service Greeter {
+		//@Attribute description:sayHello
+		//@Attribute output_privacy:NoPrivacy
+		//@Attribute output_cached:NoCached
+		rpc SayHello (HelloRequest) returns (HelloReply) {}
+	}
+
+	// The request message containing the user's name.
+	message HelloRequest {
+		string name = 1;
+	}
+
+	// The response message containing the greetings
+	message HelloReply {
+		string message = 1;
+	}`
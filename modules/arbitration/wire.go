@@ -0,0 +1,19 @@
+package arbitration
+
+import (
+	"github.com/irisnet/irishub/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgComplain{}, "iris-hub/arbitration/MsgComplain", nil)
+	cdc.RegisterConcrete(MsgArbitrate{}, "iris-hub/arbitration/MsgArbitrate", nil)
+
+	cdc.RegisterConcrete(Complaint{}, "iris-hub/arbitration/Complaint", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
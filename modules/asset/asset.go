@@ -0,0 +1,81 @@
+package asset
+
+import (
+	"regexp"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const (
+	// the symbols starting with it are reserved for the native token
+	reservedSymbolPrefix = "iris"
+
+	MaxAssetNameLength = 32
+	MaxAssetDecimal    = 18
+)
+
+var reSymbol = regexp.MustCompile(`^[a-z]{3,8}$`)
+
+// Asset - a fungible token issued by a user. The supplies are counted in the min unit of the token.
+type Asset struct {
+	Symbol      string         `json:"symbol"`
+	Name        string         `json:"name"`
+	Decimal     uint8          `json:"decimal"`
+	Owner       sdk.AccAddress `json:"owner"`
+	TotalSupply sdk.Int        `json:"total_supply"`
+	MaxSupply   sdk.Int        `json:"max_supply"` // zero for an unlimited supply
+	Mintable    bool           `json:"mintable"`   // false once the owner has frozen the minting
+}
+
+func NewAsset(symbol, name string, decimal uint8, owner sdk.AccAddress, totalSupply, maxSupply sdk.Int) Asset {
+	return Asset{
+		Symbol:      symbol,
+		Name:        name,
+		Decimal:     decimal,
+		Owner:       owner,
+		TotalSupply: totalSupply,
+		MaxSupply:   maxSupply,
+		Mintable:    true,
+	}
+}
+
+// CoinType of the asset, the units go from the symbol down to the unit of the asset's decimal
+func (asset Asset) CoinType() sdk.CoinType {
+	var units sdk.Units
+	var minUnit sdk.Unit
+	for _, unit := range sdk.GetDefaultUnits(asset.Symbol) {
+		if unit.Decimal <= int(asset.Decimal) {
+			units = append(units, unit)
+		}
+		if unit.Decimal == int(asset.Decimal) {
+			minUnit = unit
+		}
+	}
+	return sdk.CoinType{
+		Name:    asset.Symbol,
+		MinUnit: minUnit,
+		Units:   units,
+		Origin:  sdk.UserIssued,
+		Desc:    asset.Name,
+	}
+}
+
+// MinDenom - the denom the asset is held in
+func (asset Asset) MinDenom() string {
+	return asset.CoinType().MinUnit.Denom
+}
+
+// unlimited max supply or the total supply stays within it
+func (asset Asset) withinMaxSupply(totalSupply sdk.Int) bool {
+	return asset.MaxSupply.IsZero() || !totalSupply.GT(asset.MaxSupply)
+}
+
+func validSymbol(symbol string) bool {
+	return reSymbol.MatchString(symbol) && !strings.HasPrefix(symbol, reservedSymbolPrefix)
+}
+
+// the decimal must match a unit of the coin types
+func validDecimal(decimal uint8) bool {
+	return decimal <= MaxAssetDecimal && decimal%3 == 0
+}
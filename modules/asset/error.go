@@ -0,0 +1,57 @@
+package asset
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 27
+
+	CodeInvalidAssetSymbol  sdk.CodeType = 100
+	CodeInvalidAssetName    sdk.CodeType = 101
+	CodeInvalidAssetDecimal sdk.CodeType = 102
+	CodeInvalidAssetSupply  sdk.CodeType = 103
+	CodeAssetExists         sdk.CodeType = 104
+	CodeAssetNotExists      sdk.CodeType = 105
+	CodeNotAssetOwner       sdk.CodeType = 106
+	CodeAssetMintFrozen     sdk.CodeType = 107
+	CodeMaxSupplyExceeded   sdk.CodeType = 108
+)
+
+func ErrInvalidAssetSymbol(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAssetSymbol, fmt.Sprintf("invalid asset symbol %s, must be 3 to 8 lowercase letters and not start with %s", symbol, reservedSymbolPrefix))
+}
+
+func ErrInvalidAssetName(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAssetName, fmt.Sprintf("invalid asset name %s, length must be greater than 0 and less than or equal to %d", name, MaxAssetNameLength))
+}
+
+func ErrInvalidAssetDecimal(codespace sdk.CodespaceType, decimal uint8) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAssetDecimal, fmt.Sprintf("invalid asset decimal %d, must be a multiple of 3 and not greater than %d", decimal, MaxAssetDecimal))
+}
+
+func ErrInvalidAssetSupply(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAssetSupply, msg)
+}
+
+func ErrAssetExists(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeAssetExists, fmt.Sprintf("coin type %s already exists", symbol))
+}
+
+func ErrAssetNotExists(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeAssetNotExists, fmt.Sprintf("asset %s is not existed", symbol))
+}
+
+func ErrNotAssetOwner(codespace sdk.CodespaceType, symbol string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotAssetOwner, fmt.Sprintf("%s is not the owner of asset %s", addr, symbol))
+}
+
+func ErrAssetMintFrozen(codespace sdk.CodespaceType, symbol string) sdk.Error {
+	return sdk.NewError(codespace, CodeAssetMintFrozen, fmt.Sprintf("the minting of asset %s is frozen", symbol))
+}
+
+func ErrMaxSupplyExceeded(codespace sdk.CodespaceType, symbol string, maxSupply sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxSupplyExceeded, fmt.Sprintf("the total supply of asset %s would exceed its max supply %s", symbol, maxSupply))
+}
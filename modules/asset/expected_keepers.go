@@ -0,0 +1,10 @@
+package asset
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// expected distribution keeper
+type DistributionKeeper interface {
+	AddCommunityPoolCoins(ctx sdk.Context, coins sdk.Coins)
+}
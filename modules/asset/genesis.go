@@ -0,0 +1,69 @@
+package asset
+
+import (
+	"github.com/irisnet/irishub/modules/asset/params"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// GenesisState - all asset state that must be provided at genesis
+type GenesisState struct {
+	IssueFee sdk.Coin `json:"issue_fee"`
+	MintFee  sdk.Coin `json:"mint_fee"`
+	Assets   []Asset  `json:"assets"`
+}
+
+func NewGenesisState(issueFee, mintFee sdk.Coin, assets []Asset) GenesisState {
+	return GenesisState{
+		IssueFee: issueFee,
+		MintFee:  mintFee,
+		Assets:   assets,
+	}
+}
+
+// InitGenesis - store genesis parameters and assets, the balances of the assets come with the accounts
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	params.InitGenesisParameter(&assetparams.IssueFeeParameter, ctx, data.IssueFee)
+	params.InitGenesisParameter(&assetparams.MintFeeParameter, ctx, data.MintFee)
+
+	for _, asset := range data.Assets {
+		keeper.SetAsset(ctx, asset)
+		keeper.SetCoinType(ctx, asset.CoinType())
+	}
+}
+
+// ExportGenesis - output genesis parameters and assets
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	assets := []Asset{}
+	iterator := k.GetAssets(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var asset Asset
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &asset)
+		assets = append(assets, asset)
+	}
+
+	return GenesisState{
+		IssueFee: assetparams.GetIssueFee(ctx),
+		MintFee:  assetparams.GetMintFee(ctx),
+		Assets:   assets,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		IssueFee: sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(1000, 18)),
+		MintFee:  sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(10, 18)),
+		Assets:   []Asset{},
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisStateForTest() GenesisState {
+	return GenesisState{
+		IssueFee: sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(10, 18)),
+		MintFee:  sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(1, 18)),
+		Assets:   []Asset{},
+	}
+}
@@ -0,0 +1,97 @@
+package asset
+
+import (
+	"github.com/irisnet/irishub/modules/asset/tags"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// handle all "asset" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgIssueAsset:
+			return handleMsgIssueAsset(ctx, k, msg)
+		case MsgMintAsset:
+			return handleMsgMintAsset(ctx, k, msg)
+		case MsgBurnAsset:
+			return handleMsgBurnAsset(ctx, k, msg)
+		case MsgTransferAssetOwnership:
+			return handleMsgTransferAssetOwnership(ctx, k, msg)
+		case MsgFreezeAssetMint:
+			return handleMsgFreezeAssetMint(ctx, k, msg)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in asset module").Result()
+		}
+	}
+}
+
+func handleMsgIssueAsset(ctx sdk.Context, k Keeper, msg MsgIssueAsset) sdk.Result {
+	asset := NewAsset(msg.Symbol, msg.Name, msg.Decimal, msg.Owner, msg.InitialSupply, msg.MaxSupply)
+	coinTags, err := k.IssueAsset(ctx, asset)
+	if err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionIssueAsset,
+		tags.Symbol, []byte(msg.Symbol),
+		tags.Owner, []byte(msg.Owner.String()),
+	)
+	return sdk.Result{
+		Tags: resTags.AppendTags(coinTags),
+	}
+}
+
+func handleMsgMintAsset(ctx sdk.Context, k Keeper, msg MsgMintAsset) sdk.Result {
+	coinTags, err := k.MintAsset(ctx, msg.Owner, msg.Symbol, msg.Amount, msg.Recipient)
+	if err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionMintAsset,
+		tags.Symbol, []byte(msg.Symbol),
+	)
+	return sdk.Result{
+		Tags: resTags.AppendTags(coinTags),
+	}
+}
+
+func handleMsgBurnAsset(ctx sdk.Context, k Keeper, msg MsgBurnAsset) sdk.Result {
+	coinTags, err := k.BurnAsset(ctx, msg.Owner, msg.Symbol, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionBurnAsset,
+		tags.Symbol, []byte(msg.Symbol),
+	)
+	return sdk.Result{
+		Tags: resTags.AppendTags(coinTags),
+	}
+}
+
+func handleMsgTransferAssetOwnership(ctx sdk.Context, k Keeper, msg MsgTransferAssetOwnership) sdk.Result {
+	if err := k.TransferAssetOwnership(ctx, msg.Owner, msg.Symbol, msg.NewOwner); err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionTransferAssetOwnership,
+		tags.Symbol, []byte(msg.Symbol),
+		tags.Owner, []byte(msg.NewOwner.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgFreezeAssetMint(ctx sdk.Context, k Keeper, msg MsgFreezeAssetMint) sdk.Result {
+	if err := k.FreezeAssetMint(ctx, msg.Owner, msg.Symbol); err != nil {
+		return err.Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionFreezeAssetMint,
+		tags.Symbol, []byte(msg.Symbol),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
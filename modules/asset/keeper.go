@@ -0,0 +1,178 @@
+package asset
+
+import (
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/asset/params"
+	"github.com/irisnet/irishub/modules/bank"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+	// the coin types are kept in the params store, where the clients look them up
+	paramsKey sdk.StoreKey
+	cdc       *codec.Codec
+	ck        bank.Keeper
+	dk        DistributionKeeper
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKey sdk.StoreKey, ck bank.Keeper, dk DistributionKeeper, codespace sdk.CodespaceType) Keeper {
+	keeper := Keeper{
+		storeKey:  key,
+		paramsKey: paramsKey,
+		cdc:       cdc,
+		ck:        ck,
+		dk:        dk,
+		codespace: codespace,
+	}
+	return keeper
+}
+
+// return the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+func (k Keeper) SetAsset(ctx sdk.Context, asset Asset) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(asset)
+	store.Set(GetAssetKey(asset.Symbol), bz)
+}
+
+func (k Keeper) GetAsset(ctx sdk.Context, symbol string) (asset Asset, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetAssetKey(symbol))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &asset)
+		return asset, true
+	}
+	return asset, false
+}
+
+// Gets all assets
+func (k Keeper) GetAssets(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, AssetsSubspace)
+}
+
+func (k Keeper) HasCoinType(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.paramsKey)
+	return store.Has([]byte(sdk.CoinTypeKey(name)))
+}
+
+func (k Keeper) SetCoinType(ctx sdk.Context, coinType sdk.CoinType) {
+	store := ctx.KVStore(k.paramsKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(coinType)
+	store.Set([]byte(sdk.CoinTypeKey(coinType.Name)), bz)
+}
+
+// Issue an asset, the initial supply goes to the owner
+func (k Keeper) IssueAsset(ctx sdk.Context, asset Asset) (sdk.Tags, sdk.Error) {
+	if _, found := k.GetAsset(ctx, asset.Symbol); found || k.HasCoinType(ctx, asset.Symbol) {
+		return nil, ErrAssetExists(k.codespace, asset.Symbol)
+	}
+	if err := k.payFee(ctx, asset.Owner, assetparams.GetIssueFee(ctx)); err != nil {
+		return nil, err
+	}
+
+	k.SetAsset(ctx, asset)
+	k.SetCoinType(ctx, asset.CoinType())
+
+	if asset.TotalSupply.IsZero() {
+		return sdk.EmptyTags(), nil
+	}
+	return k.ck.MintCoins(ctx, asset.Owner, sdk.Coins{sdk.NewCoin(asset.MinDenom(), asset.TotalSupply)})
+}
+
+// Mint more of an asset, only the owner can mint until the minting is frozen
+func (k Keeper) MintAsset(ctx sdk.Context, owner sdk.AccAddress, symbol string, amount sdk.Int, recipient sdk.AccAddress) (sdk.Tags, sdk.Error) {
+	asset, err := k.getOwnedAsset(ctx, owner, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if !asset.Mintable {
+		return nil, ErrAssetMintFrozen(k.codespace, symbol)
+	}
+	totalSupply := asset.TotalSupply.Add(amount)
+	if !asset.withinMaxSupply(totalSupply) {
+		return nil, ErrMaxSupplyExceeded(k.codespace, symbol, asset.MaxSupply)
+	}
+	if err := k.payFee(ctx, owner, assetparams.GetMintFee(ctx)); err != nil {
+		return nil, err
+	}
+
+	asset.TotalSupply = totalSupply
+	k.SetAsset(ctx, asset)
+
+	return k.ck.MintCoins(ctx, recipient, sdk.Coins{sdk.NewCoin(asset.MinDenom(), amount)})
+}
+
+// Burn an amount of an asset held by the owner
+func (k Keeper) BurnAsset(ctx sdk.Context, owner sdk.AccAddress, symbol string, amount sdk.Int) (sdk.Tags, sdk.Error) {
+	asset, err := k.getOwnedAsset(ctx, owner, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := k.ck.BurnCoins(ctx, owner, sdk.Coins{sdk.NewCoin(asset.MinDenom(), amount)})
+	if err != nil {
+		return nil, err
+	}
+
+	asset.TotalSupply = asset.TotalSupply.Sub(amount)
+	k.SetAsset(ctx, asset)
+	return tags, nil
+}
+
+func (k Keeper) TransferAssetOwnership(ctx sdk.Context, owner sdk.AccAddress, symbol string, newOwner sdk.AccAddress) sdk.Error {
+	asset, err := k.getOwnedAsset(ctx, owner, symbol)
+	if err != nil {
+		return err
+	}
+
+	asset.Owner = newOwner
+	k.SetAsset(ctx, asset)
+	return nil
+}
+
+// Freeze the minting of an asset for good, the total supply can only go down afterwards
+func (k Keeper) FreezeAssetMint(ctx sdk.Context, owner sdk.AccAddress, symbol string) sdk.Error {
+	asset, err := k.getOwnedAsset(ctx, owner, symbol)
+	if err != nil {
+		return err
+	}
+	if !asset.Mintable {
+		return ErrAssetMintFrozen(k.codespace, symbol)
+	}
+
+	asset.Mintable = false
+	k.SetAsset(ctx, asset)
+	return nil
+}
+
+func (k Keeper) getOwnedAsset(ctx sdk.Context, owner sdk.AccAddress, symbol string) (Asset, sdk.Error) {
+	asset, found := k.GetAsset(ctx, symbol)
+	if !found {
+		return asset, ErrAssetNotExists(k.codespace, symbol)
+	}
+	if !asset.Owner.Equals(owner) {
+		return asset, ErrNotAssetOwner(k.codespace, symbol, owner)
+	}
+	return asset, nil
+}
+
+// the fees go to the community pool
+func (k Keeper) payFee(ctx sdk.Context, addr sdk.AccAddress, fee sdk.Coin) sdk.Error {
+	if !fee.IsPositive() {
+		return nil
+	}
+	fees := sdk.Coins{fee}
+	if _, _, err := k.ck.SubtractCoins(ctx, addr, fees); err != nil {
+		return err
+	}
+	k.dk.AddCommunityPoolCoins(ctx, fees)
+	return nil
+}
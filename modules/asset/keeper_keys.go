@@ -0,0 +1,15 @@
+package asset
+
+import (
+	"fmt"
+)
+
+var (
+	assetKey = "a/%s" // a/<symbol>
+
+	AssetsSubspace = []byte("a/") // all the assets
+)
+
+func GetAssetKey(symbol string) []byte {
+	return []byte(fmt.Sprintf(assetKey, symbol))
+}
@@ -0,0 +1,102 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/modules/asset/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestKeeper_IssueAsset(t *testing.T) {
+	mapp, keeper, dk, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	issueFee := assetparams.GetIssueFee(ctx)
+	balance := keeper.ck.GetCoins(ctx, addrs[0])
+
+	asset := NewAsset("abc", "ABC token", 6, addrs[0], sdk.NewIntWithDecimal(100, 6), sdk.NewIntWithDecimal(1000, 6))
+	_, err := keeper.IssueAsset(ctx, asset)
+	require.Nil(t, err)
+
+	// the initial supply goes to the owner, the fee to the community pool
+	require.Equal(t, "abc-micro", asset.MinDenom())
+	require.Equal(t, sdk.NewIntWithDecimal(100, 6), keeper.ck.GetCoins(ctx, addrs[0]).AmountOf("abc-micro"))
+	require.Equal(t, balance.AmountOf("iris-atto").Sub(issueFee.Amount), keeper.ck.GetCoins(ctx, addrs[0]).AmountOf("iris-atto"))
+	require.Equal(t, sdk.NewDecFromInt(issueFee.Amount), dk.GetFeePool(ctx).CommunityPool.AmountOf("iris-atto"))
+
+	// the coin type is registered with the units down to the decimal
+	require.True(t, keeper.HasCoinType(ctx, "abc"))
+	coinType := asset.CoinType()
+	require.Equal(t, 3, len(coinType.Units))
+	require.Equal(t, sdk.UserIssued, coinType.Origin)
+
+	_, err = keeper.IssueAsset(ctx, asset)
+	require.NotNil(t, err)
+	require.Equal(t, CodeAssetExists, err.Code())
+}
+
+func TestKeeper_MintBurnAsset(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	asset := NewAsset("abc", "ABC token", 0, addrs[0], sdk.NewInt(100), sdk.NewInt(150))
+	_, err := keeper.IssueAsset(ctx, asset)
+	require.Nil(t, err)
+
+	_, err = keeper.MintAsset(ctx, addrs[1], "abc", sdk.NewInt(10), addrs[1])
+	require.NotNil(t, err)
+	require.Equal(t, CodeNotAssetOwner, err.Code())
+
+	_, err = keeper.MintAsset(ctx, addrs[0], "abc", sdk.NewInt(50), addrs[1])
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(50), keeper.ck.GetCoins(ctx, addrs[1]).AmountOf("abc"))
+
+	_, err = keeper.MintAsset(ctx, addrs[0], "abc", sdk.NewInt(1), addrs[1])
+	require.NotNil(t, err)
+	require.Equal(t, CodeMaxSupplyExceeded, err.Code())
+
+	_, err = keeper.BurnAsset(ctx, addrs[0], "abc", sdk.NewInt(30))
+	require.Nil(t, err)
+	asset, _ = keeper.GetAsset(ctx, "abc")
+	require.Equal(t, sdk.NewInt(120), asset.TotalSupply)
+	require.Equal(t, sdk.NewInt(70), keeper.ck.GetCoins(ctx, addrs[0]).AmountOf("abc"))
+
+	_, err = keeper.BurnAsset(ctx, addrs[0], "abc", sdk.NewInt(71))
+	require.NotNil(t, err)
+}
+
+func TestKeeper_TransferOwnershipAndFreeze(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	asset := NewAsset("abc", "ABC token", 0, addrs[0], sdk.NewInt(100), sdk.ZeroInt())
+	_, err := keeper.IssueAsset(ctx, asset)
+	require.Nil(t, err)
+
+	require.Nil(t, keeper.TransferAssetOwnership(ctx, addrs[0], "abc", addrs[1]))
+	require.NotNil(t, keeper.FreezeAssetMint(ctx, addrs[0], "abc"))
+
+	// no max supply
+	_, err = keeper.MintAsset(ctx, addrs[1], "abc", sdk.NewInt(1000), addrs[1])
+	require.Nil(t, err)
+
+	require.Nil(t, keeper.FreezeAssetMint(ctx, addrs[1], "abc"))
+	_, err = keeper.MintAsset(ctx, addrs[1], "abc", sdk.NewInt(1), addrs[1])
+	require.NotNil(t, err)
+	require.Equal(t, CodeAssetMintFrozen, err.Code())
+}
+
+func TestMsgIssueAsset_ValidateBasic(t *testing.T) {
+	_, _, _, addrs, _, _ := getMockApp(t, 1)
+
+	require.Nil(t, NewMsgIssueAsset(addrs[0], "abc", "ABC", 18, sdk.NewInt(1), sdk.ZeroInt()).ValidateBasic())
+	require.NotNil(t, NewMsgIssueAsset(addrs[0], "irisx", "ABC", 18, sdk.NewInt(1), sdk.ZeroInt()).ValidateBasic())
+	require.NotNil(t, NewMsgIssueAsset(addrs[0], "ab1", "ABC", 18, sdk.NewInt(1), sdk.ZeroInt()).ValidateBasic())
+	require.NotNil(t, NewMsgIssueAsset(addrs[0], "abc", "ABC", 4, sdk.NewInt(1), sdk.ZeroInt()).ValidateBasic())
+	require.NotNil(t, NewMsgIssueAsset(addrs[0], "abc", "ABC", 18, sdk.NewInt(2), sdk.NewInt(1)).ValidateBasic())
+}
@@ -0,0 +1,237 @@
+package asset
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const MsgType = "asset"
+
+//______________________________________________________________________
+// MsgIssueAsset - struct for issuing an asset, the supplies are in the min unit of the asset
+type MsgIssueAsset struct {
+	Owner         sdk.AccAddress `json:"owner"`
+	Symbol        string         `json:"symbol"`
+	Name          string         `json:"name"`
+	Decimal       uint8          `json:"decimal"`
+	InitialSupply sdk.Int        `json:"initial_supply"`
+	MaxSupply     sdk.Int        `json:"max_supply"` // zero for an unlimited supply
+}
+
+func NewMsgIssueAsset(owner sdk.AccAddress, symbol, name string, decimal uint8, initialSupply, maxSupply sdk.Int) MsgIssueAsset {
+	return MsgIssueAsset{
+		Owner:         owner,
+		Symbol:        symbol,
+		Name:          name,
+		Decimal:       decimal,
+		InitialSupply: initialSupply,
+		MaxSupply:     maxSupply,
+	}
+}
+func (msg MsgIssueAsset) Route() string { return MsgType }
+func (msg MsgIssueAsset) Type() string  { return "asset issue" }
+func (msg MsgIssueAsset) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgIssueAsset) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if !validSymbol(msg.Symbol) {
+		return ErrInvalidAssetSymbol(DefaultCodespace, msg.Symbol)
+	}
+	if len(msg.Name) == 0 || len(msg.Name) > MaxAssetNameLength {
+		return ErrInvalidAssetName(DefaultCodespace, msg.Name)
+	}
+	if !validDecimal(msg.Decimal) {
+		return ErrInvalidAssetDecimal(DefaultCodespace, msg.Decimal)
+	}
+	if msg.InitialSupply.Sign() < 0 {
+		return ErrInvalidAssetSupply(DefaultCodespace, "initial supply can't be negative")
+	}
+	if msg.MaxSupply.Sign() < 0 {
+		return ErrInvalidAssetSupply(DefaultCodespace, "max supply can't be negative")
+	}
+	if !msg.MaxSupply.IsZero() && msg.InitialSupply.GT(msg.MaxSupply) {
+		return ErrInvalidAssetSupply(DefaultCodespace, fmt.Sprintf("initial supply %s exceeds max supply %s", msg.InitialSupply, msg.MaxSupply))
+	}
+	return nil
+}
+
+func (msg MsgIssueAsset) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+//______________________________________________________________________
+// MsgMintAsset - struct for minting more of an asset to a recipient
+type MsgMintAsset struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Symbol    string         `json:"symbol"`
+	Amount    sdk.Int        `json:"amount"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+func NewMsgMintAsset(owner sdk.AccAddress, symbol string, amount sdk.Int, recipient sdk.AccAddress) MsgMintAsset {
+	return MsgMintAsset{
+		Owner:     owner,
+		Symbol:    symbol,
+		Amount:    amount,
+		Recipient: recipient,
+	}
+}
+func (msg MsgMintAsset) Route() string { return MsgType }
+func (msg MsgMintAsset) Type() string  { return "asset mint" }
+func (msg MsgMintAsset) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgMintAsset) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.Recipient) == 0 {
+		return sdk.ErrInvalidAddress(msg.Recipient.String())
+	}
+	if !validSymbol(msg.Symbol) {
+		return ErrInvalidAssetSymbol(DefaultCodespace, msg.Symbol)
+	}
+	if msg.Amount.Sign() <= 0 {
+		return ErrInvalidAssetSupply(DefaultCodespace, "amount to mint must be positive")
+	}
+	return nil
+}
+
+func (msg MsgMintAsset) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+//______________________________________________________________________
+// MsgBurnAsset - struct for burning an amount of an asset held by the owner
+type MsgBurnAsset struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Amount sdk.Int        `json:"amount"`
+}
+
+func NewMsgBurnAsset(owner sdk.AccAddress, symbol string, amount sdk.Int) MsgBurnAsset {
+	return MsgBurnAsset{
+		Owner:  owner,
+		Symbol: symbol,
+		Amount: amount,
+	}
+}
+func (msg MsgBurnAsset) Route() string { return MsgType }
+func (msg MsgBurnAsset) Type() string  { return "asset burn" }
+func (msg MsgBurnAsset) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgBurnAsset) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if !validSymbol(msg.Symbol) {
+		return ErrInvalidAssetSymbol(DefaultCodespace, msg.Symbol)
+	}
+	if msg.Amount.Sign() <= 0 {
+		return ErrInvalidAssetSupply(DefaultCodespace, "amount to burn must be positive")
+	}
+	return nil
+}
+
+func (msg MsgBurnAsset) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+//______________________________________________________________________
+// MsgTransferAssetOwnership - struct for handing an asset over to a new owner
+type MsgTransferAssetOwnership struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Symbol   string         `json:"symbol"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+}
+
+func NewMsgTransferAssetOwnership(owner sdk.AccAddress, symbol string, newOwner sdk.AccAddress) MsgTransferAssetOwnership {
+	return MsgTransferAssetOwnership{
+		Owner:    owner,
+		Symbol:   symbol,
+		NewOwner: newOwner,
+	}
+}
+func (msg MsgTransferAssetOwnership) Route() string { return MsgType }
+func (msg MsgTransferAssetOwnership) Type() string  { return "asset transfer-ownership" }
+func (msg MsgTransferAssetOwnership) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgTransferAssetOwnership) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.NewOwner) == 0 {
+		return sdk.ErrInvalidAddress(msg.NewOwner.String())
+	}
+	if !validSymbol(msg.Symbol) {
+		return ErrInvalidAssetSymbol(DefaultCodespace, msg.Symbol)
+	}
+	return nil
+}
+
+func (msg MsgTransferAssetOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+//______________________________________________________________________
+// MsgFreezeAssetMint - struct for freezing the minting of an asset for good
+type MsgFreezeAssetMint struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+}
+
+func NewMsgFreezeAssetMint(owner sdk.AccAddress, symbol string) MsgFreezeAssetMint {
+	return MsgFreezeAssetMint{
+		Owner:  owner,
+		Symbol: symbol,
+	}
+}
+func (msg MsgFreezeAssetMint) Route() string { return MsgType }
+func (msg MsgFreezeAssetMint) Type() string  { return "asset freeze-mint" }
+func (msg MsgFreezeAssetMint) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgFreezeAssetMint) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if !validSymbol(msg.Symbol) {
+		return ErrInvalidAssetSymbol(DefaultCodespace, msg.Symbol)
+	}
+	return nil
+}
+
+func (msg MsgFreezeAssetMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
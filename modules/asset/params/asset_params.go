@@ -0,0 +1,164 @@
+package assetparams
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var IssueFeeParameter IssueFeeParam
+
+var _ params.GovParameter = (*IssueFeeParam)(nil)
+
+// IssueFeeParam - the fee paid to the community pool for issuing an asset
+type IssueFeeParam struct {
+	Value      sdk.Coin
+	paramSpace params.Subspace
+}
+
+func (param *IssueFeeParam) InitGenesis(genesisState interface{}) {
+	param.Value = genesisState.(sdk.Coin)
+}
+
+func (param *IssueFeeParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *IssueFeeParam) GetStoreKey() []byte {
+	return []byte("assetIssueFee")
+}
+
+func (param *IssueFeeParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *IssueFeeParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+func (param *IssueFeeParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *IssueFeeParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *IssueFeeParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *IssueFeeParam) Valid(jsonStr string) sdk.Error {
+
+	var err error
+
+	if err = json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		if param.Value.Denom != "iris-atto" {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidIssueFee, fmt.Sprintf("It should be iris-atto!"))
+		}
+		if !param.Value.IsNotNegative() {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidIssueFee, fmt.Sprintf("Invalid IssueFee [%s] should not be negative", param.Value.String()))
+		}
+		return nil
+
+	}
+	return sdk.NewError(params.DefaultCodespace, params.CodeInvalidIssueFee, fmt.Sprintf("Json is not valid"))
+}
+
+var MintFeeParameter MintFeeParam
+
+var _ params.GovParameter = (*MintFeeParam)(nil)
+
+// MintFeeParam - the fee paid to the community pool for minting more of an asset
+type MintFeeParam struct {
+	Value      sdk.Coin
+	paramSpace params.Subspace
+}
+
+func (param *MintFeeParam) InitGenesis(genesisState interface{}) {
+	param.Value = genesisState.(sdk.Coin)
+}
+
+func (param *MintFeeParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *MintFeeParam) GetStoreKey() []byte {
+	return []byte("assetMintFee")
+}
+
+func (param *MintFeeParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *MintFeeParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+func (param *MintFeeParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *MintFeeParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *MintFeeParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *MintFeeParam) Valid(jsonStr string) sdk.Error {
+
+	var err error
+
+	if err = json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		if param.Value.Denom != "iris-atto" {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMintFee, fmt.Sprintf("It should be iris-atto!"))
+		}
+		if !param.Value.IsNotNegative() {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMintFee, fmt.Sprintf("Invalid MintFee [%s] should not be negative", param.Value.String()))
+		}
+		return nil
+
+	}
+	return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMintFee, fmt.Sprintf("Json is not valid"))
+}
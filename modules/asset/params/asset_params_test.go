@@ -0,0 +1,58 @@
+package assetparams
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	"github.com/irisnet/irishub/store"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func defaultContext(key sdk.StoreKey, tkeyParams *sdk.TransientStoreKey) sdk.Context {
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	cms.LoadLatestVersion()
+	ctx := sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+	return ctx
+}
+
+func TestIssueFeeParameter(t *testing.T) {
+	skey := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	ctx := defaultContext(skey, tkeyParams)
+	cdc := codec.New()
+
+	paramKeeper := params.NewKeeper(
+		cdc,
+		skey, tkeyParams,
+	)
+
+	subspace := paramKeeper.Subspace("Gov").WithTypeTable(params.NewTypeTable(
+		IssueFeeParameter.GetStoreKey(), sdk.Coin{},
+		MintFeeParameter.GetStoreKey(), sdk.Coin{},
+	))
+
+	IssueFeeParameter.SetReadWriter(subspace)
+	find := IssueFeeParameter.LoadValue(ctx)
+	require.Equal(t, find, false)
+
+	fee := sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(1000, 18))
+	params.InitGenesisParameter(&IssueFeeParameter, ctx, fee)
+	require.Equal(t, fee, GetIssueFee(ctx))
+
+	require.Nil(t, IssueFeeParameter.Valid(`{"denom":"iris-atto","amount":"10"}`))
+	require.NotNil(t, IssueFeeParameter.Valid(`{"denom":"abc","amount":"10"}`))
+	require.NotNil(t, IssueFeeParameter.Valid(`{"denom":"iris-atto","amount":"-10"}`))
+
+	IssueFeeParameter.Update(ctx, `{"denom":"iris-atto","amount":"10"}`)
+	require.Equal(t, sdk.NewCoin("iris-atto", sdk.NewInt(10)), GetIssueFee(ctx))
+}
@@ -0,0 +1,25 @@
+package assetparams
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+func GetIssueFee(ctx sdk.Context) sdk.Coin {
+	IssueFeeParameter.LoadValue(ctx)
+	return IssueFeeParameter.Value
+}
+
+func SetIssueFee(ctx sdk.Context, fee sdk.Coin) {
+	IssueFeeParameter.Value = fee
+	IssueFeeParameter.SaveValue(ctx)
+}
+
+func GetMintFee(ctx sdk.Context) sdk.Coin {
+	MintFeeParameter.LoadValue(ctx)
+	return MintFeeParameter.Value
+}
+
+func SetMintFee(ctx sdk.Context, fee sdk.Coin) {
+	MintFeeParameter.Value = fee
+	MintFeeParameter.SaveValue(ctx)
+}
@@ -0,0 +1,70 @@
+package asset
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the asset Querier
+const (
+	QueryAsset  = "asset"
+	QueryAssets = "assets"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryAsset:
+			return queryAsset(ctx, path[1:], req, keeper)
+		case QueryAssets:
+			return queryAssets(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown asset query endpoint")
+		}
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/asset/asset'
+type QueryAssetParams struct {
+	Symbol string
+}
+
+// nolint: unparam
+func queryAsset(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryAssetParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	asset, found := keeper.GetAsset(ctx, params.Symbol)
+	if !found {
+		return nil, ErrAssetNotExists(DefaultCodespace, params.Symbol)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, asset)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryAssets(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	assets := []Asset{}
+	iterator := keeper.GetAssets(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var asset Asset
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &asset)
+		assets = append(assets, asset)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, assets)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,18 @@
+package tags
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var (
+	ActionIssueAsset             = []byte("asset-issue")
+	ActionMintAsset              = []byte("asset-mint")
+	ActionBurnAsset              = []byte("asset-burn")
+	ActionTransferAssetOwnership = []byte("asset-transfer-ownership")
+	ActionFreezeAssetMint        = []byte("asset-freeze-mint")
+
+	Action = sdk.TagAction
+
+	Symbol = "symbol"
+	Owner  = "owner"
+)
@@ -0,0 +1,68 @@
+package asset
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/stake"
+	"github.com/irisnet/irishub/simulation/mock"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// initialize the mock application for this module
+func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, distribution.Keeper, []sdk.AccAddress, []crypto.PubKey, []crypto.PrivKey) {
+	mapp := mock.NewApp()
+
+	stake.RegisterCodec(mapp.Cdc)
+	RegisterCodec(mapp.Cdc)
+
+	keyAsset := sdk.NewKVStoreKey("asset")
+	keyDistr := sdk.NewKVStoreKey("distr")
+
+	ck := bank.NewBaseKeeper(mapp.Cdc, mapp.KeyBank, mapp.AccountKeeper)
+	sk := stake.NewKeeper(
+		mapp.Cdc,
+		mapp.KeyStake, mapp.TkeyStake,
+		mapp.BankKeeper, mapp.ParamsKeeper.Subspace(stake.DefaultParamspace),
+		mapp.RegisterCodespace(stake.DefaultCodespace))
+	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, mapp.ParamsKeeper.Subspace(distribution.DefaultParamspace),
+		ck, sk, mapp.FeeCollectionKeeper, distribution.DefaultCodespace)
+	ak := NewKeeper(mapp.Cdc, keyAsset, mapp.KeyParams, ck, dk, DefaultCodespace)
+
+	mapp.Router().AddRoute("asset", []*sdk.KVStoreKey{keyAsset, mapp.KeyAccount, mapp.KeyParams, keyDistr}, NewHandler(ak))
+
+	mapp.SetInitChainer(getInitChainer(mapp, ak, sk, dk))
+
+	require.NoError(t, mapp.CompleteSetup(keyAsset, keyDistr))
+
+	coin, _ := sdk.NewDefaultCoinType("iris").ConvertToMinCoin(fmt.Sprintf("%d%s", 1042, "iris"))
+	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
+
+	mock.SetGenesis(mapp, genAccs)
+
+	return mapp, ak, dk, addrs, pubKeys, privKeys
+}
+
+// asset and distribution initchainer
+func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper, distrKeeper distribution.Keeper) sdk.InitChainer {
+	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+
+		validators, err := stake.InitGenesis(ctx, stakeKeeper, stake.DefaultGenesisState())
+		if err != nil {
+			panic(err)
+		}
+		distribution.InitGenesis(ctx, distrKeeper, distribution.DefaultGenesisState())
+		InitGenesis(ctx, keeper, DefaultGenesisStateForTest())
+		return abci.ResponseInitChain{
+			Validators: validators,
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package asset
+
+import (
+	"github.com/irisnet/irishub/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueAsset{}, "iris-hub/asset/MsgIssueAsset", nil)
+	cdc.RegisterConcrete(MsgMintAsset{}, "iris-hub/asset/MsgMintAsset", nil)
+	cdc.RegisterConcrete(MsgBurnAsset{}, "iris-hub/asset/MsgBurnAsset", nil)
+	cdc.RegisterConcrete(MsgTransferAssetOwnership{}, "iris-hub/asset/MsgTransferAssetOwnership", nil)
+	cdc.RegisterConcrete(MsgFreezeAssetMint{}, "iris-hub/asset/MsgFreezeAssetMint", nil)
+	cdc.RegisterConcrete(Asset{}, "iris-hub/asset/Asset", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
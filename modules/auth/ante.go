@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
+
+	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
@@ -88,7 +92,7 @@ func NewAnteHandler(am AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		// first sig pays the fees
 		if !stdTx.Fee.Amount.IsZero() {
 			// signerAccs[0] is the fee payer
-			signerAccs[0], res = deductFees(signerAccs[0], stdTx.Fee)
+			signerAccs[0], res = deductFees(newCtx.BlockHeader().Time, signerAccs[0], stdTx.Fee)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -186,7 +190,10 @@ func processSig(ctx sdk.Context,
 		return nil, sdk.ErrInternal("setting PubKey on signer's account").Result()
 	}
 
-	consumeSignatureVerificationGas(ctx.GasMeter(), pubKey)
+	res = consumeSignatureVerificationGas(ctx.GasMeter(), sig.Signature, pubKey, simulate)
+	if !res.IsOK() {
+		return nil, res
+	}
 	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
 		return nil, sdk.ErrUnauthorized("signature verification failed").Result()
 	}
@@ -235,17 +242,58 @@ func processPubKey(acc Account, sig StdSignature, simulate bool) (crypto.PubKey,
 	return pubKey, sdk.Result{}
 }
 
-func consumeSignatureVerificationGas(meter sdk.GasMeter, pubkey crypto.PubKey) {
-	switch pubkey.(type) {
+// consumeSignatureVerificationGas charges the gas of verifying a signature, a multisignature
+// is charged for every subkey that signed it
+func consumeSignatureVerificationGas(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, simulate bool) sdk.Result {
+	switch pubkey := pubkey.(type) {
 	case ed25519.PubKeyEd25519:
 		meter.ConsumeGas(ed25519VerifyCost, "ante verify: ed25519")
+		return sdk.Result{}
 	case secp256k1.PubKeySecp256k1:
 		meter.ConsumeGas(secp256k1VerifyCost, "ante verify: secp256k1")
+		return sdk.Result{}
+	case multisig.PubKeyMultisigThreshold:
+		return consumeMultisignatureVerificationGas(meter, sig, pubkey, simulate)
 	default:
-		panic("Unrecognized signature type")
+		return sdk.ErrInvalidPubKey(fmt.Sprintf("unrecognized public key type: %T", pubkey)).Result()
 	}
 }
 
+func consumeMultisignatureVerificationGas(meter sdk.GasMeter, sig []byte, pubkey multisig.PubKeyMultisigThreshold, simulate bool) sdk.Result {
+	// the simulated tx carries no signature, every subkey is charged
+	if simulate {
+		for _, subKey := range pubkey.PubKeys {
+			if res := consumeSignatureVerificationGas(meter, nil, subKey, simulate); !res.IsOK() {
+				return res
+			}
+		}
+		return sdk.Result{}
+	}
+
+	var multisignature multisig.Multisignature
+	if err := codec.Cdc.UnmarshalBinaryBare(sig, &multisignature); err != nil {
+		return sdk.ErrUnauthorized("invalid multisignature").Result()
+	}
+	if multisignature.BitArray == nil || multisignature.BitArray.Size() != len(pubkey.PubKeys) {
+		return sdk.ErrUnauthorized("multisignature doesn't match the multisig public key").Result()
+	}
+
+	sigIndex := 0
+	for i, subKey := range pubkey.PubKeys {
+		if !multisignature.BitArray.GetIndex(i) {
+			continue
+		}
+		if sigIndex >= len(multisignature.Sigs) {
+			return sdk.ErrUnauthorized("multisignature doesn't match the multisig public key").Result()
+		}
+		if res := consumeSignatureVerificationGas(meter, multisignature.Sigs[sigIndex], subKey, simulate); !res.IsOK() {
+			return res
+		}
+		sigIndex++
+	}
+	return sdk.Result{}
+}
+
 func adjustFeesByGas(fees sdk.Coins, gas int64) sdk.Coins {
 	gasCost := gas / gasPerUnitCost
 	gasFees := make(sdk.Coins, len(fees))
@@ -259,15 +307,17 @@ func adjustFeesByGas(fees sdk.Coins, gas int64) sdk.Coins {
 // Deduct the fee from the account.
 // We could use the CoinKeeper (in addition to the AccountKeeper,
 // because the CoinKeeper doesn't give us accounts), but it seems easier to do this.
-func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
+func deductFees(blockTime time.Time, acc Account, fee StdFee) (Account, sdk.Result) {
 	coins := acc.GetCoins()
 	feeAmount := fee.Amount
 
-	newCoins := coins.Minus(feeAmount)
-	if !newCoins.IsNotNegative() {
-		errMsg := fmt.Sprintf("%s < %s", coins, feeAmount)
+	// the locked coins of a vesting account can't pay fees
+	spendableCoins := SpendableCoins(acc, blockTime)
+	if !spendableCoins.Minus(feeAmount).IsNotNegative() {
+		errMsg := fmt.Sprintf("%s < %s", spendableCoins, feeAmount)
 		return nil, sdk.ErrInsufficientFunds(errMsg).Result()
 	}
+	newCoins := coins.Minus(feeAmount)
 	err := acc.SetCoins(newCoins)
 	if err != nil {
 		// Handle w/ #870
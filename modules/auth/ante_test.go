@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/multisig/bitarray"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// a public key of a type the ante handler doesn't know
+type unknownPubKey struct{}
+
+func (pubKey unknownPubKey) Address() crypto.Address                 { return nil }
+func (pubKey unknownPubKey) Bytes() []byte                           { return nil }
+func (pubKey unknownPubKey) VerifyBytes(msg []byte, sig []byte) bool { return false }
+func (pubKey unknownPubKey) Equals(other crypto.PubKey) bool         { return false }
+
+func newMultisignature(bits []bool, sigs int) []byte {
+	bitArray := bitarray.NewCompactBitArray(len(bits))
+	for i, bit := range bits {
+		bitArray.SetIndex(i, bit)
+	}
+	multisignature := multisig.Multisignature{BitArray: bitArray}
+	for i := 0; i < sigs; i++ {
+		multisignature.Sigs = append(multisignature.Sigs, []byte("signature"))
+	}
+	return codec.Cdc.MustMarshalBinaryBare(multisignature)
+}
+
+func TestConsumeMultisignatureVerificationGas(t *testing.T) {
+	pubKey := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
+		ed25519.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		ed25519.GenPrivKey().PubKey(),
+	}).(multisig.PubKeyMultisigThreshold)
+
+	consume := func(sig []byte, pubKey multisig.PubKeyMultisigThreshold, simulate bool) (sdk.Gas, sdk.Result) {
+		meter := sdk.NewInfiniteGasMeter()
+		res := consumeMultisignatureVerificationGas(meter, sig, pubKey, simulate)
+		return meter.GasConsumed(), res
+	}
+
+	// only the subkeys that signed are charged
+	gas, res := consume(newMultisignature([]bool{true, true, false}, 2), pubKey, false)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.Gas(ed25519VerifyCost+secp256k1VerifyCost), gas)
+
+	gas, res = consume(newMultisignature([]bool{true, false, true}, 2), pubKey, false)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.Gas(2*ed25519VerifyCost), gas)
+
+	// a simulated tx carries no signature, every subkey is charged
+	gas, res = consume(nil, pubKey, true)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.Gas(2*ed25519VerifyCost+secp256k1VerifyCost), gas)
+
+	// the multisignature has to match the public key
+	_, res = consume([]byte("multisignature"), pubKey, false)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+
+	_, res = consume(codec.Cdc.MustMarshalBinaryBare(multisig.Multisignature{}), pubKey, false)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+
+	_, res = consume(newMultisignature([]bool{true, true}, 2), pubKey, false)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+
+	_, res = consume(newMultisignature([]bool{true, true, true, false}, 3), pubKey, false)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+
+	// fewer signatures than signers
+	_, res = consume(newMultisignature([]bool{true, true, true}, 2), pubKey, false)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+
+	// a subkey of an unknown type is rejected
+	unknownKey := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{
+		ed25519.GenPrivKey().PubKey(),
+		unknownPubKey{},
+	}).(multisig.PubKeyMultisigThreshold)
+	require.NotPanics(t, func() {
+		_, res = consume(newMultisignature([]bool{false, true}, 1), unknownKey, false)
+	})
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidPubKey), res.Code)
+
+	_, res = consume(nil, unknownKey, true)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidPubKey), res.Code)
+}
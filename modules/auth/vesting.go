@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// VestingAccount is an account whose coins are locked until a schedule
+// releases them. The locked coins can't be spent, but they can be delegated.
+type VestingAccount interface {
+	Account
+
+	// SpendableCoins returns the coins the account can send or pay fees with at blockTime
+	SpendableCoins(blockTime time.Time) sdk.Coins
+	// TrackDelegation takes the delegated amount from the account, locked coins are delegated first
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	// TrackUndelegation gives the undelegated amount back to the account, free coins are undelegated first
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// SpendableCoins returns the coins of an account that can be spent at blockTime
+func SpendableCoins(acc Account, blockTime time.Time) sdk.Coins {
+	if vacc, ok := acc.(VestingAccount); ok {
+		return vacc.SpendableCoins(blockTime)
+	}
+	return acc.GetCoins()
+}
+
+//-----------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount - the common part of the vesting accounts. The coins of the
+// account include neither DelegatedFree nor DelegatedVesting, the coins delegated
+// out of the free and the locked coins. EndTime is a unix time in seconds.
+type BaseVestingAccount struct {
+	*BaseAccount
+
+	OriginalVesting  sdk.Coins `json:"original_vesting"`
+	DelegatedFree    sdk.Coins `json:"delegated_free"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
+
+	EndTime int64 `json:"end_time"`
+}
+
+// the spendable amount of every denom is min((balance + delegated vesting) - vesting, balance):
+// the locked coins that are delegated don't lock the balance anymore
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	spendableCoins := sdk.Coins{}
+	for _, coin := range bva.GetCoins() {
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		spendable := sdk.MinInt(coin.Amount.Add(delVestingAmt).Sub(vestingAmt), coin.Amount)
+		if spendable.Sign() > 0 {
+			spendableCoins = spendableCoins.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, spendable)})
+		}
+	}
+	return spendableCoins
+}
+
+// the locked coins are delegated first: the amount x := min(max(vesting - delegated vesting, 0), delegation)
+// is added to DelegatedVesting and the rest of the delegation to DelegatedFree
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	if !bva.GetCoins().Minus(amount).IsNotNegative() {
+		panic("delegation attempt with insufficient funds")
+	}
+
+	for _, coin := range amount {
+		notDelegated := vestingCoins.AmountOf(coin.Denom).Sub(bva.DelegatedVesting.AmountOf(coin.Denom))
+		if notDelegated.Sign() < 0 {
+			notDelegated = sdk.ZeroInt()
+		}
+		x := sdk.MinInt(notDelegated, coin.Amount)
+		y := coin.Amount.Sub(x)
+
+		if x.Sign() > 0 {
+			bva.DelegatedVesting = bva.DelegatedVesting.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if y.Sign() > 0 {
+			bva.DelegatedFree = bva.DelegatedFree.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+	}
+
+	bva.Coins = bva.Coins.Minus(amount)
+}
+
+// TrackUndelegation gives the undelegated amount back to the account. The free coins are
+// undelegated first: x := min(delegated free, undelegation) is taken from DelegatedFree and
+// the rest from DelegatedVesting. An undelegation smaller than the delegation, after a slash,
+// leaves the remainder tracked.
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		x := sdk.MinInt(bva.DelegatedFree.AmountOf(coin.Denom), coin.Amount)
+		y := sdk.MinInt(bva.DelegatedVesting.AmountOf(coin.Denom), coin.Amount.Sub(x))
+
+		if x.Sign() > 0 {
+			bva.DelegatedFree = bva.DelegatedFree.Minus(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if y.Sign() > 0 {
+			bva.DelegatedVesting = bva.DelegatedVesting.Minus(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+	}
+
+	bva.Coins = bva.Coins.Plus(amount)
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+//-----------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ VestingAccount = (*ContinuousVestingAccount)(nil)
+
+// ContinuousVestingAccount - releases the original vesting linearly between
+// StartTime and EndTime, unix times in seconds
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime int64 `json:"start_time"`
+}
+
+func NewContinuousVestingAccount(baseAcc *BaseAccount, startTime, endTime int64) *ContinuousVestingAccount {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: &BaseVestingAccount{
+			BaseAccount:     baseAcc,
+			OriginalVesting: baseAcc.Coins,
+			EndTime:         endTime,
+		},
+		StartTime: startTime,
+	}
+}
+
+// GetVestedCoins returns the share of the original vesting released at blockTime
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	now := blockTime.Unix()
+	if now <= cva.StartTime {
+		return nil
+	}
+	if now >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	vestedCoins := sdk.Coins{}
+	share := sdk.NewDec(now - cva.StartTime).QuoInt(sdk.NewInt(cva.EndTime - cva.StartTime))
+	for _, coin := range cva.OriginalVesting {
+		vestedAmt := share.MulInt(coin.Amount).TruncateInt()
+		if vestedAmt.Sign() > 0 {
+			vestedCoins = vestedCoins.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, vestedAmt)})
+		}
+	}
+	return vestedCoins
+}
+
+// GetVestingCoins returns the share of the original vesting still locked at blockTime
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Minus(cva.GetVestedCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// Implements VestingAccount
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+//-----------------------------------------------------------
+// DelayedVestingAccount
+
+var _ VestingAccount = (*DelayedVestingAccount)(nil)
+
+// DelayedVestingAccount - releases all the original vesting at EndTime, a unix time in seconds
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+func NewDelayedVestingAccount(baseAcc *BaseAccount, endTime int64) *DelayedVestingAccount {
+	return &DelayedVestingAccount{
+		BaseVestingAccount: &BaseVestingAccount{
+			BaseAccount:     baseAcc,
+			OriginalVesting: baseAcc.Coins,
+			EndTime:         endTime,
+		},
+	}
+}
+
+// GetVestedCoins returns the original vesting once blockTime reaches EndTime
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+	return nil
+}
+
+// GetVestingCoins returns the original vesting until blockTime reaches EndTime
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Minus(dva.GetVestedCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// Implements VestingAccount
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// ValidateVestingSchedule checks the times of a vesting schedule, a delayed
+// vesting has no start time
+func ValidateVestingSchedule(startTime, endTime int64) error {
+	if endTime <= 0 {
+		return errors.New("the vesting end time must be positive")
+	}
+	if startTime < 0 || startTime >= endTime {
+		return errors.New("the vesting start time must be before the end time")
+	}
+	return nil
+}
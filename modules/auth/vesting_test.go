@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+var (
+	vestingStart = time.Unix(1000, 0)
+	vestingEnd   = time.Unix(2000, 0)
+)
+
+func irisCoins(amount int64) sdk.Coins {
+	return sdk.Coins{sdk.NewInt64Coin("iris-atto", amount)}
+}
+
+func newBaseAccount(coins sdk.Coins) *BaseAccount {
+	acc := NewBaseAccountWithAddress(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	acc.SetCoins(coins)
+	return &acc
+}
+
+func TestContinuousVestingAccount_GetVestedCoins(t *testing.T) {
+	coins := sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 100)}
+	acc := NewContinuousVestingAccount(newBaseAccount(coins), vestingStart.Unix(), vestingEnd.Unix())
+
+	// nothing is released until the start time
+	require.Nil(t, acc.GetVestedCoins(vestingStart.Add(-time.Second)))
+	require.Nil(t, acc.GetVestedCoins(vestingStart))
+	require.True(t, acc.GetVestingCoins(vestingStart).IsEqual(coins))
+
+	// the coins are released linearly, rounded down
+	require.True(t, acc.GetVestedCoins(vestingStart.Add(500*time.Second)).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 5), sdk.NewInt64Coin("iris-atto", 50)}))
+	require.True(t, acc.GetVestedCoins(vestingStart.Add(150*time.Second)).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 1), sdk.NewInt64Coin("iris-atto", 15)}))
+	require.True(t, acc.GetVestingCoins(vestingStart.Add(150*time.Second)).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 9), sdk.NewInt64Coin("iris-atto", 85)}))
+	require.True(t, acc.GetVestedCoins(vestingStart.Add(50*time.Second)).IsEqual(irisCoins(5)))
+
+	// everything is released at the end time
+	require.True(t, acc.GetVestedCoins(vestingEnd).IsEqual(coins))
+	require.True(t, acc.GetVestedCoins(vestingEnd.Add(time.Hour)).IsEqual(coins))
+	require.True(t, acc.GetVestingCoins(vestingEnd).IsZero())
+}
+
+func TestDelayedVestingAccount_GetVestedCoins(t *testing.T) {
+	acc := NewDelayedVestingAccount(newBaseAccount(irisCoins(100)), vestingEnd.Unix())
+
+	require.Nil(t, acc.GetVestedCoins(vestingStart))
+	require.Nil(t, acc.GetVestedCoins(vestingEnd.Add(-time.Second)))
+	require.True(t, acc.GetVestingCoins(vestingEnd.Add(-time.Second)).IsEqual(irisCoins(100)))
+
+	require.True(t, acc.GetVestedCoins(vestingEnd).IsEqual(irisCoins(100)))
+	require.True(t, acc.GetVestingCoins(vestingEnd).IsZero())
+	require.Equal(t, int64(0), acc.GetStartTime())
+}
+
+func TestVestingAccount_SpendableCoins(t *testing.T) {
+	midTime := vestingStart.Add(500 * time.Second)
+
+	cva := NewContinuousVestingAccount(newBaseAccount(irisCoins(100)), vestingStart.Unix(), vestingEnd.Unix())
+	require.True(t, cva.SpendableCoins(vestingStart).IsZero())
+	require.True(t, cva.SpendableCoins(midTime).IsEqual(irisCoins(50)))
+	require.True(t, cva.SpendableCoins(vestingEnd).IsEqual(irisCoins(100)))
+
+	// the coins received are spendable
+	cva.SetCoins(cva.GetCoins().Plus(sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 20)}))
+	require.True(t, cva.SpendableCoins(vestingStart).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 20)}))
+	require.True(t, cva.SpendableCoins(midTime).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 70)}))
+
+	dva := NewDelayedVestingAccount(newBaseAccount(irisCoins(100)), vestingEnd.Unix())
+	require.True(t, dva.SpendableCoins(midTime).IsZero())
+	require.True(t, dva.SpendableCoins(vestingEnd).IsEqual(irisCoins(100)))
+
+	// a base account spends all its coins
+	require.True(t, SpendableCoins(newBaseAccount(irisCoins(100)), vestingStart).IsEqual(irisCoins(100)))
+	require.True(t, SpendableCoins(dva, midTime).IsZero())
+}
+
+func TestVestingAccount_TrackDelegation(t *testing.T) {
+	midTime := vestingStart.Add(500 * time.Second)
+
+	// the locked coins are delegated first
+	cva := NewContinuousVestingAccount(newBaseAccount(irisCoins(100)), vestingStart.Unix(), vestingEnd.Unix())
+	cva.TrackDelegation(midTime, irisCoins(30))
+	require.True(t, cva.GetDelegatedVesting().IsEqual(irisCoins(30)))
+	require.True(t, cva.GetDelegatedFree().IsZero())
+	require.True(t, cva.GetCoins().IsEqual(irisCoins(70)))
+	// the delegated locked coins don't lock the balance anymore
+	require.True(t, cva.SpendableCoins(midTime).IsEqual(irisCoins(50)))
+
+	cva.TrackDelegation(midTime, irisCoins(40))
+	require.True(t, cva.GetDelegatedVesting().IsEqual(irisCoins(50)))
+	require.True(t, cva.GetDelegatedFree().IsEqual(irisCoins(20)))
+	require.True(t, cva.GetCoins().IsEqual(irisCoins(30)))
+	require.True(t, cva.SpendableCoins(midTime).IsEqual(irisCoins(30)))
+
+	// all the coins are free once released
+	dva := NewDelayedVestingAccount(newBaseAccount(irisCoins(100)), vestingEnd.Unix())
+	dva.TrackDelegation(vestingEnd, irisCoins(100))
+	require.True(t, dva.GetDelegatedVesting().IsZero())
+	require.True(t, dva.GetDelegatedFree().IsEqual(irisCoins(100)))
+	require.True(t, dva.GetCoins().IsZero())
+
+	// no more than the coins of the account can be delegated
+	dva = NewDelayedVestingAccount(newBaseAccount(irisCoins(100)), vestingEnd.Unix())
+	require.Panics(t, func() { dva.TrackDelegation(midTime, irisCoins(101)) })
+}
+
+func TestVestingAccount_TrackUndelegation(t *testing.T) {
+	midTime := vestingStart.Add(500 * time.Second)
+
+	// the free coins are undelegated first
+	cva := NewContinuousVestingAccount(newBaseAccount(irisCoins(100)), vestingStart.Unix(), vestingEnd.Unix())
+	cva.TrackDelegation(midTime, irisCoins(70))
+	cva.TrackUndelegation(irisCoins(10))
+	require.True(t, cva.GetDelegatedFree().IsEqual(irisCoins(10)))
+	require.True(t, cva.GetDelegatedVesting().IsEqual(irisCoins(50)))
+	require.True(t, cva.GetCoins().IsEqual(irisCoins(40)))
+
+	cva.TrackUndelegation(irisCoins(30))
+	require.True(t, cva.GetDelegatedFree().IsZero())
+	require.True(t, cva.GetDelegatedVesting().IsEqual(irisCoins(30)))
+	require.True(t, cva.GetCoins().IsEqual(irisCoins(70)))
+
+	// after a slash the undelegation is smaller than the delegation, the rest stays tracked
+	dva := NewDelayedVestingAccount(newBaseAccount(irisCoins(100)), vestingEnd.Unix())
+	dva.TrackDelegation(midTime, irisCoins(100))
+	require.True(t, dva.GetDelegatedVesting().IsEqual(irisCoins(100)))
+	dva.TrackUndelegation(irisCoins(50))
+	require.True(t, dva.GetDelegatedVesting().IsEqual(irisCoins(50)))
+	require.True(t, dva.GetCoins().IsEqual(irisCoins(50)))
+	require.True(t, dva.SpendableCoins(midTime).IsZero())
+	require.True(t, dva.SpendableCoins(vestingEnd).IsEqual(irisCoins(50)))
+}
+
+func TestValidateVestingSchedule(t *testing.T) {
+	require.Nil(t, ValidateVestingSchedule(0, 2000))
+	require.Nil(t, ValidateVestingSchedule(1000, 2000))
+
+	require.NotNil(t, ValidateVestingSchedule(0, 0))
+	require.NotNil(t, ValidateVestingSchedule(0, -1))
+	require.NotNil(t, ValidateVestingSchedule(-1, 2000))
+	require.NotNil(t, ValidateVestingSchedule(2000, 2000))
+	require.NotNil(t, ValidateVestingSchedule(3000, 2000))
+}
+
+func TestDeductFees_VestingAccount(t *testing.T) {
+	midTime := vestingStart.Add(500 * time.Second)
+	fee := StdFee{Amount: irisCoins(60), Gas: 10000}
+
+	// the locked coins can't pay fees
+	cva := NewContinuousVestingAccount(newBaseAccount(irisCoins(100)), vestingStart.Unix(), vestingEnd.Unix())
+	_, res := deductFees(midTime, cva, fee)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInsufficientFunds), res.Code)
+	require.True(t, cva.GetCoins().IsEqual(irisCoins(100)))
+
+	acc, res := deductFees(vestingStart.Add(600*time.Second), cva, fee)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, acc.GetCoins().IsEqual(irisCoins(40)))
+}
@@ -8,6 +8,7 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/Send", nil)
 	cdc.RegisterConcrete(MsgIssue{}, "cosmos-sdk/Issue", nil)
+	cdc.RegisterConcrete(MsgBurn{}, "cosmos-sdk/Burn", nil)
 }
 
 var msgCdc = codec.New()
@@ -9,8 +9,9 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 2
 
-	CodeInvalidInput  sdk.CodeType = 101
-	CodeInvalidOutput sdk.CodeType = 102
+	CodeInvalidInput     sdk.CodeType = 101
+	CodeInvalidOutput    sdk.CodeType = 102
+	CodeSupplyNotTracked sdk.CodeType = 103
 )
 
 // NOTE: Don't stringer this, we'll put better messages in later.
@@ -20,6 +21,8 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid input coins"
 	case CodeInvalidOutput:
 		return "invalid output coins"
+	case CodeSupplyNotTracked:
+		return "the supply is not tracked yet"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -44,6 +47,10 @@ func ErrNoOutputs(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidOutput, "")
 }
 
+func ErrSupplyNotTracked(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeSupplyNotTracked, "")
+}
+
 //----------------------------------------
 
 func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
@@ -3,6 +3,7 @@ package bank
 import (
 	"fmt"
 
+	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/auth"
 )
@@ -16,25 +17,49 @@ const (
 )
 
 // Keeper defines a module interface that facilitates the transfer of coins
-// between accounts.
+// between accounts and keeps the total supply of every denom.
+//
+// AddCoins and SubtractCoins move coins between the accounts and the holdings
+// of the modules (bonded tokens, collected fees, pools, deposits), they leave
+// the supply untouched. Coins are only created or destroyed through
+// IncreaseSupply and DecreaseSupply, or MintCoins and BurnCoins for coins held
+// by an account. The supply is kept once it has been initialized by InitSupply.
 type Keeper interface {
 	SendKeeper
 	SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+
+	IsSupplyTracked(ctx sdk.Context) bool
+	InitSupply(ctx sdk.Context, supply sdk.Coins)
+	GetSupply(ctx sdk.Context, denom string) sdk.Int
+	GetTotalSupply(ctx sdk.Context) sdk.Coins
+	IncreaseSupply(ctx sdk.Context, amt sdk.Coins)
+	DecreaseSupply(ctx sdk.Context, amt sdk.Coins)
+	MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+
+	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 }
 
 var _ Keeper = (*BaseKeeper)(nil)
 
-// BaseKeeper manages transfers between accounts. It implements the Keeper
-// interface.
+// BaseKeeper manages transfers between accounts and the supply store. It
+// implements the Keeper interface.
 type BaseKeeper struct {
-	am auth.AccountKeeper
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	am       auth.AccountKeeper
 }
 
 // NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(am auth.AccountKeeper) BaseKeeper {
-	return BaseKeeper{am: am}
+func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey, am auth.AccountKeeper) BaseKeeper {
+	return BaseKeeper{
+		storeKey: key,
+		cdc:      cdc,
+		am:       am,
+	}
 }
 
 // GetCoins returns the coins at the addr.
@@ -81,6 +106,37 @@ func (keeper BaseKeeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outpu
 	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
 }
 
+// DelegateCoins takes the delegated amt from the coins at the addr, the locked
+// coins of a vesting account can be delegated.
+func (keeper BaseKeeper) DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	return delegateCoins(ctx, keeper.am, addr, amt)
+}
+
+// UndelegateCoins gives the undelegated amt back to the coins at the addr.
+func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	return undelegateCoins(ctx, keeper.am, addr, amt)
+}
+
+// MintCoins creates amt at the addr and adds it to the supply.
+func (keeper BaseKeeper) MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	_, tags, err := addCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+	keeper.IncreaseSupply(ctx, amt)
+	return tags, nil
+}
+
+// BurnCoins destroys amt at the addr and removes it from the supply.
+func (keeper BaseKeeper) BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	_, tags, err := subtractCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+	keeper.DecreaseSupply(ctx, amt)
+	return tags, nil
+}
+
 //______________________________________________________________________________________________
 
 // SendKeeper defines a module interface that facilitates the transfer of coins
@@ -172,6 +228,14 @@ func getCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress) sdk.C
 	return acc.GetCoins()
 }
 
+func getSpendableCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress) sdk.Coins {
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.Coins{}
+	}
+	return auth.SpendableCoins(acc, ctx.BlockHeader().Time)
+}
+
 func setCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	ctx.GasMeter().ConsumeGas(costSetCoins, "setCoins")
 	acc := am.GetAccount(ctx, addr)
@@ -197,10 +261,12 @@ func hasCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 func subtractCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
 	ctx.GasMeter().ConsumeGas(costSubtractCoins, "subtractCoins")
 	oldCoins := getCoins(ctx, am, addr)
-	newCoins := oldCoins.Minus(amt)
-	if !newCoins.IsNotNegative() {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	// the locked coins of a vesting account can't be spent
+	spendableCoins := getSpendableCoins(ctx, am, addr)
+	if !spendableCoins.Minus(amt).IsNotNegative() {
+		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", spendableCoins, amt))
 	}
+	newCoins := oldCoins.Minus(amt)
 	err := setCoins(ctx, am, addr, newCoins)
 	tags := sdk.NewTags("sender", []byte(addr.String()))
 	return newCoins, tags, err
@@ -219,6 +285,48 @@ func addCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 	return newCoins, tags, err
 }
 
+// DelegateCoins takes the delegated amt from the coins at the addr. The locked
+// coins of a vesting account can be delegated, the account tracks them.
+func delegateCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	ctx.GasMeter().ConsumeGas(costSubtractCoins, "delegateCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr))
+	}
+	oldCoins := acc.GetCoins()
+	newCoins := oldCoins.Minus(amt)
+	if !newCoins.IsNotNegative() {
+		return nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	} else if err := acc.SetCoins(newCoins); err != nil {
+		// Handle w/ #870
+		panic(err)
+	}
+	am.SetAccount(ctx, acc)
+	return sdk.NewTags("sender", []byte(addr.String())), nil
+}
+
+// UndelegateCoins gives the undelegated amt back to the coins at the addr.
+func undelegateCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	ctx.GasMeter().ConsumeGas(costAddCoins, "undelegateCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = am.NewAccountWithAddress(ctx, addr)
+	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+	} else if err := acc.SetCoins(acc.GetCoins().Plus(amt)); err != nil {
+		// Handle w/ #870
+		panic(err)
+	}
+	am.SetAccount(ctx, acc)
+	return sdk.NewTags("recipient", []byte(addr.String())), nil
+}
+
 // SendCoins moves coins from one account to another
 // NOTE: Make sure to revert state changes from tx on error
 func sendCoins(ctx sdk.Context, am auth.AccountKeeper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
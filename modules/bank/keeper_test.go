@@ -0,0 +1,230 @@
+package bank
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/auth"
+	"github.com/irisnet/irishub/store"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	addr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, BaseKeeper) {
+	keyAcc := sdk.NewKVStoreKey("acc")
+	keyBank := sdk.NewKVStoreKey("bank")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	am := auth.NewAccountKeeper(cdc, keyAcc, auth.ProtoBaseAccount)
+	return ctx, am, NewBaseKeeper(cdc, keyBank, am)
+}
+
+func irisCoins(amount int64) sdk.Coins {
+	return sdk.Coins{sdk.NewInt64Coin("iris-atto", amount)}
+}
+
+func TestKeeper_Supply(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+
+	// the supply is kept once it has been initialized
+	keeper.IncreaseSupply(ctx, irisCoins(100))
+	require.False(t, keeper.IsSupplyTracked(ctx))
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").IsZero())
+	require.Equal(t, 0, len(keeper.GetTotalSupply(ctx)))
+
+	keeper.InitSupply(ctx, sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 100)})
+	require.True(t, keeper.IsSupplyTracked(ctx))
+	keeper.IncreaseSupply(ctx, irisCoins(50))
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(150)))
+	require.True(t, keeper.GetTotalSupply(ctx).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 150)}))
+
+	// the supply of a denom burned completely is removed
+	keeper.DecreaseSupply(ctx, sdk.Coins{sdk.NewInt64Coin("btc", 10)})
+	require.True(t, keeper.GetTotalSupply(ctx).IsEqual(irisCoins(150)))
+
+	// the supply can't be negative
+	require.Panics(t, func() { keeper.DecreaseSupply(ctx, irisCoins(151)) })
+	require.Panics(t, func() { keeper.DecreaseSupply(ctx, sdk.Coins{sdk.NewInt64Coin("btc", 1)}) })
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(150)))
+}
+
+func TestKeeper_MintAndBurnCoins(t *testing.T) {
+	ctx, am, keeper := createTestInput(t)
+	keeper.InitSupply(ctx, sdk.Coins{})
+	am.SetAccount(ctx, am.NewAccountWithAddress(ctx, addr1))
+
+	_, err := keeper.MintCoins(ctx, addr1, irisCoins(100))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(100)))
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(100)))
+
+	// moving coins between the accounts leaves the supply untouched
+	_, err = keeper.SendCoins(ctx, addr1, addr2, irisCoins(40))
+	require.Nil(t, err)
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(100)))
+
+	_, err = keeper.BurnCoins(ctx, addr1, irisCoins(30))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(30)))
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(70)))
+
+	// an account can't burn more than it holds
+	_, err = keeper.BurnCoins(ctx, addr1, irisCoins(31))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(30)))
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(70)))
+}
+
+func TestHandler_MsgBurn(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	handler := NewHandler(keeper)
+	_, err := keeper.MintCoins(ctx, addr1, irisCoins(100))
+	require.Nil(t, err)
+
+	// coins can't be burned before the supply is tracked
+	res := handler(ctx, NewMsgBurn(addr1, irisCoins(60)))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSupplyNotTracked), res.Code)
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(100)))
+
+	keeper.InitSupply(ctx, irisCoins(100))
+	res = handler(ctx, NewMsgBurn(addr1, irisCoins(60)))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(40)))
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(40)))
+
+	res = handler(ctx, NewMsgBurn(addr1, irisCoins(60)))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInsufficientCoins), res.Code)
+}
+
+func TestMsgBurn_ValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgBurn(addr1, irisCoins(1)).ValidateBasic())
+	require.Nil(t, NewMsgBurn(addr1, sdk.Coins{sdk.NewInt64Coin("btc", 1), sdk.NewInt64Coin("iris-atto", 1)}).ValidateBasic())
+
+	cases := []MsgBurn{
+		NewMsgBurn(nil, irisCoins(1)),
+		NewMsgBurn(addr1, sdk.Coins{}),
+		NewMsgBurn(addr1, irisCoins(0)),
+		NewMsgBurn(addr1, irisCoins(-1)),
+		NewMsgBurn(addr1, sdk.Coins{sdk.NewInt64Coin("iris-atto", 1), sdk.NewInt64Coin("btc", 1)}),
+	}
+	for _, msg := range cases {
+		require.NotNil(t, msg.ValidateBasic(), msg.Coins.String())
+	}
+	require.Equal(t, []sdk.AccAddress{addr1}, NewMsgBurn(addr1, irisCoins(1)).GetSigners())
+}
+
+func TestQuerier_Supply(t *testing.T) {
+	ctx, _, keeper := createTestInput(t)
+	keeper.InitSupply(ctx, sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 100)})
+
+	querier := NewQuerier(keeper)
+	query := func(params QuerySupplyParams) sdk.Coins {
+		res, err := querier(ctx, []string{QuerySupply}, abci.RequestQuery{Data: msgCdc.MustMarshalJSON(params)})
+		require.Nil(t, err)
+		var supply sdk.Coins
+		msgCdc.MustUnmarshalJSON(res, &supply)
+		return supply
+	}
+
+	require.True(t, query(QuerySupplyParams{}).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris-atto", 100)}))
+	require.True(t, query(QuerySupplyParams{Denom: "btc"}).IsEqual(sdk.Coins{sdk.NewInt64Coin("btc", 10)}))
+
+	// a denom without supply is zero
+	supply := query(QuerySupplyParams{Denom: "eth"})
+	require.Equal(t, 1, len(supply))
+	require.True(t, supply[0].Amount.IsZero())
+
+	_, err := querier(ctx, []string{QuerySupply}, abci.RequestQuery{Data: []byte("supply")})
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestKeeper_DelegateCoins(t *testing.T) {
+	ctx, am, keeper := createTestInput(t)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1500, 0)})
+
+	acc := am.NewAccountWithAddress(ctx, addr1).(*auth.BaseAccount)
+	acc.SetCoins(irisCoins(100))
+	am.SetAccount(ctx, auth.NewDelayedVestingAccount(acc, 2000))
+
+	// the locked coins can't be sent
+	_, err := keeper.SendCoins(ctx, addr1, addr2, irisCoins(1))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+
+	// but they can be delegated
+	_, err = keeper.DelegateCoins(ctx, addr1, irisCoins(100))
+	require.Nil(t, err)
+	vacc := am.GetAccount(ctx, addr1).(auth.VestingAccount)
+	require.True(t, vacc.GetCoins().IsZero())
+	require.True(t, vacc.GetDelegatedVesting().IsEqual(irisCoins(100)))
+	require.True(t, vacc.GetDelegatedFree().IsZero())
+
+	_, err = keeper.DelegateCoins(ctx, addr1, irisCoins(1))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+
+	// a slashed delegation gives back less than delegated, the coins it gives back stay locked
+	_, err = keeper.UndelegateCoins(ctx, addr1, irisCoins(80))
+	require.Nil(t, err)
+	vacc = am.GetAccount(ctx, addr1).(auth.VestingAccount)
+	require.True(t, vacc.GetCoins().IsEqual(irisCoins(80)))
+	require.True(t, vacc.GetDelegatedVesting().IsEqual(irisCoins(20)))
+
+	_, err = keeper.SendCoins(ctx, addr1, addr2, irisCoins(1))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+
+	_, err = keeper.SendCoins(ctx.WithBlockHeader(abci.Header{Time: time.Unix(2000, 0)}), addr1, addr2, irisCoins(80))
+	require.Nil(t, err)
+}
+
+func TestKeeper_DelegateCoins_BaseAccount(t *testing.T) {
+	ctx, am, keeper := createTestInput(t)
+	keeper.InitSupply(ctx, sdk.Coins{})
+	_, err := keeper.MintCoins(ctx, addr1, irisCoins(100))
+	require.Nil(t, err)
+
+	_, err = keeper.DelegateCoins(ctx, addr1, irisCoins(60))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(40)))
+
+	_, err = keeper.DelegateCoins(ctx, addr1, irisCoins(41))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+
+	_, err = keeper.DelegateCoins(ctx, addr2, irisCoins(1))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeUnknownAddress, err.Code())
+
+	_, err = keeper.UndelegateCoins(ctx, addr1, irisCoins(50))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr1).IsEqual(irisCoins(90)))
+	require.IsType(t, &auth.BaseAccount{}, am.GetAccount(ctx, addr1))
+
+	// delegating doesn't change the supply
+	require.True(t, keeper.GetSupply(ctx, "iris-atto").Equal(sdk.NewInt(100)))
+}
@@ -145,6 +145,55 @@ func (msg MsgIssue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Banker}
 }
 
+//----------------------------------------
+// MsgBurn
+
+// MsgBurn - destroys coins of the owner and takes them out of the supply
+type MsgBurn struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Coins sdk.Coins      `json:"coins"`
+}
+
+var _ sdk.Msg = MsgBurn{}
+
+// NewMsgBurn - construct a msg burning coins of the owner
+func NewMsgBurn(owner sdk.AccAddress, coins sdk.Coins) MsgBurn {
+	return MsgBurn{Owner: owner, Coins: coins}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgBurn) Route() string { return "bank" }
+func (msg MsgBurn) Type() string  { return "burn" }
+
+// Implements Msg.
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if !msg.Coins.IsValid() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	if !msg.Coins.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 //----------------------------------------
 // Input
 
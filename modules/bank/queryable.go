@@ -0,0 +1,50 @@
+package bank
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the bank Querier
+const (
+	QuerySupply = "supply"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QuerySupply:
+			return querySupply(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
+		}
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/bank/supply'
+// an empty denom queries the supply of all denoms
+type QuerySupplyParams struct {
+	Denom string
+}
+
+// nolint: unparam
+func querySupply(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QuerySupplyParams
+	err2 := msgCdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	supply := keeper.GetTotalSupply(ctx)
+	if len(params.Denom) != 0 {
+		supply = sdk.Coins{sdk.NewCoin(params.Denom, keeper.GetSupply(ctx, params.Denom))}
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(msgCdc, supply)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
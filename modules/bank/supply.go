@@ -0,0 +1,96 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var (
+	// SupplyKeyPrefix prefixes the supply of every denom
+	SupplyKeyPrefix = []byte("supply/")
+	// SupplyTrackedKey is set once the supply has been initialized
+	SupplyTrackedKey = []byte("supplyTracked")
+)
+
+// GetSupplyKey returns the key of the supply of a denom
+func GetSupplyKey(denom string) []byte {
+	return append(SupplyKeyPrefix, []byte(denom)...)
+}
+
+// GetSupply returns the total supply of a denom
+func (keeper BaseKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetSupplyKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return amount
+}
+
+func (keeper BaseKeeper) setSupply(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(keeper.storeKey)
+	if amount.IsZero() {
+		store.Delete(GetSupplyKey(denom))
+		return
+	}
+	store.Set(GetSupplyKey(denom), keeper.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
+
+// GetTotalSupply returns the supply of all denoms
+func (keeper BaseKeeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	defer iterator.Close()
+
+	supply := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		denom := string(iterator.Key()[len(SupplyKeyPrefix):])
+		supply = append(supply, sdk.NewCoin(denom, amount))
+	}
+	return supply.Sort()
+}
+
+// IsSupplyTracked returns whether the supply has been initialized, the supply is
+// only kept from then on
+func (keeper BaseKeeper) IsSupplyTracked(ctx sdk.Context) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(SupplyTrackedKey)
+}
+
+// InitSupply sets the supply of every denom and starts tracking the supply
+func (keeper BaseKeeper) InitSupply(ctx sdk.Context, supply sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(SupplyTrackedKey, []byte{byte(1)})
+	for _, coin := range supply {
+		keeper.setSupply(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// IncreaseSupply adds newly created coins to the supply
+func (keeper BaseKeeper) IncreaseSupply(ctx sdk.Context, amt sdk.Coins) {
+	if !keeper.IsSupplyTracked(ctx) {
+		return
+	}
+	for _, coin := range amt {
+		keeper.setSupply(ctx, coin.Denom, keeper.GetSupply(ctx, coin.Denom).Add(coin.Amount))
+	}
+}
+
+// DecreaseSupply removes destroyed coins from the supply
+func (keeper BaseKeeper) DecreaseSupply(ctx sdk.Context, amt sdk.Coins) {
+	if !keeper.IsSupplyTracked(ctx) {
+		return
+	}
+	for _, coin := range amt {
+		supply := keeper.GetSupply(ctx, coin.Denom).Sub(coin.Amount)
+		if supply.Sign() < 0 {
+			panic(fmt.Sprintf("supply of %s should not be negative after burning %s", coin.Denom, coin))
+		}
+		keeper.setSupply(ctx, coin.Denom, supply)
+	}
+}
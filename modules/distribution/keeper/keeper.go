@@ -55,6 +55,13 @@ func (k Keeper) SetFeePool(ctx sdk.Context, feePool types.FeePool) {
 	store.Set(FeePoolKey, b)
 }
 
+// add coins to the community pool of the global fee pool
+func (k Keeper) AddCommunityPoolCoins(ctx sdk.Context, coins sdk.Coins) {
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Plus(types.NewDecCoins(coins))
+	k.SetFeePool(ctx, feePool)
+}
+
 // get the total validator accum for the ctx height
 // in the fee pool
 func (k Keeper) GetFeePoolValAccum(ctx sdk.Context) sdk.Dec {
@@ -22,6 +22,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&ParameterProposal{}, "gov/ParameterProposal", nil)
 	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal", nil)
 	cdc.RegisterConcrete(&TerminatorProposal{}, "gov/TerminatorProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitTaxUsageProposal{}, "gov/MsgSubmitTaxUsageProposal", nil)
+	cdc.RegisterConcrete(&CommunityTaxUsageProposal{}, "gov/CommunityTaxUsageProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitSoftwareUpgradeProposal{}, "gov/MsgSubmitSoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(&SoftwareUpgradeAbortProposal{}, "gov/SoftwareUpgradeAbortProposal", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "gov/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, "gov/MsgCancelProposal", nil)
 	////////////////////  iris end  ///////////////////////////
 }
 
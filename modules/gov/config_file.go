@@ -47,6 +47,16 @@ func (pd *ParameterConfigFile) WriteFile(cdc *codec.Codec, res []sdk.KVPair , pa
 			if err != nil {
 				return err
 			}
+		case "Gov/govProposalProcedures":
+			err := cdc.UnmarshalJSON(kv.Value, &pd.Govparams.ProposalProcedures)
+			if err != nil {
+				return err
+			}
+		case "Gov/govDepositPolicy":
+			err := cdc.UnmarshalJSON(kv.Value, &pd.Govparams.DepositPolicy)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -84,6 +94,10 @@ func (pd *ParameterConfigFile) GetParamFromKey(keyStr string, opStr string) (Par
 		jsonBytes, err = json.Marshal(pd.Govparams.VotingProcedure)
 	case "Gov/govTallyingProcedure":
 		jsonBytes, err = json.Marshal(pd.Govparams.TallyingProcedure)
+	case "Gov/govProposalProcedures":
+		jsonBytes, err = json.Marshal(pd.Govparams.ProposalProcedures)
+	case "Gov/govDepositPolicy":
+		jsonBytes, err = json.Marshal(pd.Govparams.DepositPolicy)
 	default:
 		return param, sdk.NewError(params.DefaultCodespace, params.CodeInvalidKey, fmt.Sprintf(keyStr+" is not found"))
 	}
@@ -3,6 +3,7 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/pkg/errors"
@@ -12,12 +13,16 @@ import (
 type Vote struct {
 	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
 	ProposalID uint64          `json:"proposal_id"` //  proposalID of the proposal
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	Option     VoteOption     `json:"option,omitempty"` //  option from OptionSet chosen by the voter, empty for a split vote
+	////////////////////  iris begin  ///////////////////////////
+	Options WeightedVoteOptions `json:"options"` //  options with the share of voting power given to each
+	////////////////////  iris end  /////////////////////////////
 }
 
 // Returns whether 2 votes are equal
 func (voteA Vote) Equals(voteB Vote) bool {
-	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option
+	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option &&
+		voteA.Options.Equals(voteB.Options)
 }
 
 // Returns whether a vote is empty
@@ -26,6 +31,87 @@ func (voteA Vote) Empty() bool {
 	return voteA.Equals(voteB)
 }
 
+////////////////////  iris begin  ///////////////////////////
+// Returns the weighted options of the vote, votes cast before weighted votes only have the Option
+func (vote Vote) WeightedOptions() WeightedVoteOptions {
+	if len(vote.Options) == 0 && validVoteOption(vote.Option) {
+		return NewNonSplitVoteOption(vote.Option)
+	}
+	return vote.Options
+}
+
+// WeightedVoteOption - the share of the voting power of a voter given to an option
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	Weight sdk.Dec    `json:"weight"`
+}
+
+type WeightedVoteOptions []WeightedVoteOption
+
+// Gives all the voting power to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: sdk.OneDec()}}
+}
+
+// Weights must be positive and add up to 1, each option at most once
+func (options WeightedVoteOptions) ValidateBasic() sdk.Error {
+	if len(options) == 0 {
+		return ErrInvalidWeightedVote(DefaultCodespace, "no vote option")
+	}
+	total := sdk.ZeroDec()
+	for i, option := range options {
+		if !validVoteOption(option.Option) {
+			return ErrInvalidVote(DefaultCodespace, option.Option)
+		}
+		if option.Weight.IsNil() || !option.Weight.GT(sdk.ZeroDec()) || option.Weight.GT(sdk.OneDec()) {
+			return ErrInvalidWeightedVote(DefaultCodespace, fmt.Sprintf("invalid weight %s of option %s", option.Weight, option.Option))
+		}
+		for _, prev := range options[:i] {
+			if prev.Option == option.Option {
+				return ErrInvalidWeightedVote(DefaultCodespace, fmt.Sprintf("duplicate option %s", option.Option))
+			}
+		}
+		total = total.Add(option.Weight)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return ErrInvalidWeightedVote(DefaultCodespace, fmt.Sprintf("total weight %s is not 1", total))
+	}
+	return nil
+}
+
+// Returns whether 2 sets of weighted options are equal
+func (options WeightedVoteOptions) Equals(other WeightedVoteOptions) bool {
+	if len(options) != len(other) {
+		return false
+	}
+	for i, option := range options {
+		if option.Option != other[i].Option || !option.Weight.Equal(other[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// Turns the options to the form Yes=0.6,No=0.4
+func (options WeightedVoteOptions) String() string {
+	var strs []string
+	for _, option := range options {
+		strs = append(strs, fmt.Sprintf("%s=%s", option.Option, option.Weight))
+	}
+	return strings.Join(strs, ",")
+}
+
+// VoteChange - a vote replacing a previous vote of the voter on a proposal
+type VoteChange struct {
+	ProposalID uint64              `json:"proposal_id"`
+	Voter      sdk.AccAddress      `json:"voter"`
+	Height     int64               `json:"height"` // height of the block where the vote was changed
+	From       WeightedVoteOptions `json:"from"`
+	To         WeightedVoteOptions `json:"to"`
+}
+
+////////////////////  iris end  /////////////////////////////
+
 // Deposit
 type Deposit struct {
 	Depositor  sdk.AccAddress `json:"depositor"`   //  Address of the depositor
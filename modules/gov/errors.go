@@ -25,6 +25,17 @@ const (
 	CodeInvalidParam            sdk.CodeType = 12
 	CodeInvalidParamOp          sdk.CodeType = 13
 	CodeSwitchPeriodInProcess   sdk.CodeType = 14
+	CodeEmptyParams             sdk.CodeType = 15
+	CodeDuplicateParam          sdk.CodeType = 16
+	CodeNotArrayParam           sdk.CodeType = 17
+	CodeInvalidUsageType        sdk.CodeType = 18
+	CodeInvalidTaxUsagePercent  sdk.CodeType = 19
+	CodeInvalidTaxRecipients    sdk.CodeType = 20
+	CodeNotTrustee              sdk.CodeType = 21
+	CodeInvalidWeightedVote     sdk.CodeType = 22
+	CodeNotProposer             sdk.CodeType = 23
+	CodeInvalidUpgradeConfig    sdk.CodeType = 24
+	CodeNoUpgradeInProcess      sdk.CodeType = 25
 	////////////////////  iris end  /////////////////////////////
 )
 
@@ -82,4 +93,48 @@ func ErrInvalidParamOp(codespace sdk.CodespaceType, opStr string) sdk.Error {
 func ErrSwitchPeriodInProcess(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSwitchPeriodInProcess, fmt.Sprintf("Software Upgrade Switch Period is in process."))
 }
+
+func ErrEmptyParams(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptyParams, fmt.Sprintf("ParameterChange proposal must change at least one parameter"))
+}
+
+func ErrDuplicateParam(codespace sdk.CodespaceType, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateParam, fmt.Sprintf("Param '%s' is updated more than once", key))
+}
+
+func ErrNotArrayParam(codespace sdk.CodespaceType, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotArrayParam, fmt.Sprintf("Param '%s' is not an array parameter, insert is not supported", key))
+}
+
+func ErrInvalidUsageType(codespace sdk.CodespaceType, usageType UsageType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUsageType, fmt.Sprintf("Usage type '%s' is not valid", usageType))
+}
+
+func ErrInvalidTaxUsagePercent(codespace sdk.CodespaceType, percent sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTaxUsagePercent, fmt.Sprintf("Percent %s of the community pool should be larger than 0 and not larger than 1", percent))
+}
+
+func ErrInvalidTaxUsageRecipients(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTaxRecipients, fmt.Sprintf("Invalid recipients of the community pool: %s", msg))
+}
+
+func ErrNotTrustee(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotTrustee, fmt.Sprintf("%s is not a trustee", addr))
+}
+
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWeightedVote, fmt.Sprintf("Invalid weighted vote: %s", msg))
+}
+
+func ErrNotProposer(codespace sdk.CodespaceType, proposalID uint64, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotProposer, fmt.Sprintf("%s is not the proposer of proposal %d", addr, proposalID))
+}
+
+func ErrInvalidUpgradeConfig(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUpgradeConfig, msg)
+}
+
+func ErrNoUpgradeInProcess(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradeInProcess, fmt.Sprintf("There is no software upgrade in its switch period to abort"))
+}
 ////////////////////  iris end  /////////////////////////////
@@ -11,14 +11,17 @@ import (
 
 // GenesisState - all gov state that must be provided at genesis
 type GenesisState struct {
-	TerminatorPeriod   int64                       `json:"terminator_period"`
-	StartingProposalID uint64                      `json:"starting_proposalID"`
-	Deposits           []DepositWithMetadata       `json:"deposits"`
-	Votes              []VoteWithMetadata          `json:"votes"`
-	Proposals          []Proposal                  `json:"proposals"`
-	DepositProcedure   govparams.DepositProcedure  `json:"deposit_period"`
-	VotingProcedure    govparams.VotingProcedure   `json:"voting_period"`
-	TallyingProcedure  govparams.TallyingProcedure `json:"tallying_procedure"`
+	TerminatorPeriod   int64                        `json:"terminator_period"`
+	StartingProposalID uint64                       `json:"starting_proposalID"`
+	Deposits           []DepositWithMetadata        `json:"deposits"`
+	Votes              []VoteWithMetadata           `json:"votes"`
+	VoteChanges        []VoteChange                 `json:"vote_changes"`
+	Proposals          []Proposal                   `json:"proposals"`
+	DepositProcedure   govparams.DepositProcedure   `json:"deposit_period"`
+	VotingProcedure    govparams.VotingProcedure    `json:"voting_period"`
+	TallyingProcedure  govparams.TallyingProcedure  `json:"tallying_procedure"`
+	ProposalProcedures govparams.ProposalProcedures `json:"proposal_procedures"` // derived from the global procedures if empty
+	DepositPolicy      govparams.DepositPolicy      `json:"deposit_policy"`      // the default policy applies if empty
 }
 
 type DepositWithMetadata struct {
@@ -41,6 +44,14 @@ func NewGenesisState(startingProposalID uint64, dp govparams.DepositProcedure, v
 	}
 }
 
+// ValidateGenesis checks the procedures of the kinds of proposals, if they are given
+func ValidateGenesis(data GenesisState) error {
+	if len(data.ProposalProcedures) > 0 {
+		return data.ProposalProcedures.ValidateComplete()
+	}
+	return nil
+}
+
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 
@@ -58,6 +69,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	params.InitGenesisParameter(&govparams.DepositProcedureParameter, ctx, data.DepositProcedure)
 	params.InitGenesisParameter(&govparams.VotingProcedureParameter, ctx, data.VotingProcedure)
 	params.InitGenesisParameter(&govparams.TallyingProcedureParameter, ctx, data.TallyingProcedure)
+	if err := ValidateGenesis(data); err != nil {
+		panic(err)
+	}
+	proposalProcedures := data.ProposalProcedures
+	if len(proposalProcedures) == 0 {
+		proposalProcedures = govparams.NewProposalProcedures(data.DepositProcedure, data.VotingProcedure, data.TallyingProcedure)
+	}
+	params.InitGenesisParameter(&govparams.ProposalProceduresParameter, ctx, proposalProcedures)
+	if data.DepositPolicy == (govparams.DepositPolicy{}) {
+		params.InitGenesisParameter(&govparams.DepositPolicyParameter, ctx, nil)
+	} else {
+		params.InitGenesisParameter(&govparams.DepositPolicyParameter, ctx, data.DepositPolicy)
+	}
 	////////////////////  iris end  /////////////////////////////
 	for _, deposit := range data.Deposits {
 		k.setDeposit(ctx, deposit.ProposalID, deposit.Deposit.Depositor, deposit.Deposit)
@@ -65,6 +89,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	for _, vote := range data.Votes {
 		k.setVote(ctx, vote.ProposalID, vote.Vote.Voter, vote.Vote)
 	}
+	for _, change := range data.VoteChanges {
+		k.addVoteChange(ctx, change)
+	}
 	for _, proposal := range data.Proposals {
 		k.SetProposal(ctx, proposal)
 	}
@@ -77,10 +104,14 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	depositProcedure := govparams.GetDepositProcedure(ctx)
 	votingProcedure := govparams.GetVotingProcedure(ctx)
 	tallyingProcedure := govparams.GetTallyingProcedure(ctx)
+	govparams.ProposalProceduresParameter.LoadValue(ctx)
+	proposalProcedures := govparams.ProposalProceduresParameter.Value
+	depositPolicy := govparams.GetDepositPolicy(ctx)
 	////////////////////  iris end  /////////////////////////////
 
 	var deposits []DepositWithMetadata
 	var votes []VoteWithMetadata
+	var voteChanges []VoteChange
 	proposals := k.GetProposalsFiltered(ctx, nil, nil, StatusNil, 0)
 	for _, proposal := range proposals {
 		proposalID := proposal.GetProposalID()
@@ -96,15 +127,24 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 			k.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
 			votes = append(votes, VoteWithMetadata{proposalID, vote})
 		}
+		changesIterator := k.GetVoteChangesIterator(ctx, proposalID)
+		for ; changesIterator.Valid(); changesIterator.Next() {
+			var changes []VoteChange
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(changesIterator.Value(), &changes)
+			voteChanges = append(voteChanges, changes...)
+		}
 	}
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		Deposits:           deposits,
 		Votes:              votes,
+		VoteChanges:        voteChanges,
 		Proposals:          proposals,
 		DepositProcedure:   depositProcedure,
 		VotingProcedure:    votingProcedure,
 		TallyingProcedure:  tallyingProcedure,
+		ProposalProcedures: proposalProcedures,
+		DepositPolicy:      depositPolicy,
 	}
 }
 
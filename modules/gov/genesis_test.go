@@ -0,0 +1,20 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/modules/gov/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	data := DefaultGenesisState()
+	require.Nil(t, ValidateGenesis(data))
+
+	// the procedures of the kinds of proposals are derived from the global ones, or given, all of them
+	procedures := govparams.DefaultProposalProcedures()
+	data.ProposalProcedures = procedures
+	require.Nil(t, ValidateGenesis(data))
+	data.ProposalProcedures = procedures[1:]
+	require.NotNil(t, ValidateGenesis(data))
+}
@@ -20,8 +20,16 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgDeposit(ctx, keeper, msg)
 		case MsgSubmitProposal:
 			return handleMsgSubmitProposal(ctx, keeper, msg)
+		case MsgSubmitTaxUsageProposal:
+			return handleMsgSubmitTaxUsageProposal(ctx, keeper, msg)
+		case MsgSubmitSoftwareUpgradeProposal:
+			return handleMsgSubmitSoftwareUpgradeProposal(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+		case MsgCancelProposal:
+			return handleMsgCancelProposal(ctx, keeper, msg)
 		default:
 			errMsg := "Unrecognized gov msg type"
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -31,13 +39,20 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
 	////////////////////  iris begin  ///////////////////////////
-	proposal := keeper.NewProposal(ctx, msg.Title, msg.Description, msg.ProposalType,msg.Param)
+	proposal := keeper.NewProposal(ctx, msg.Title, msg.Description, msg.ProposalType, msg.Params)
+	proposal.SetProposer(msg.Proposer)
+	keeper.SetProposal(ctx, proposal)
 
 	if msg.ProposalType == ProposalTypeSoftwareUpgrade {
 		if upgradeparams.GetCurrentUpgradeProposalId(ctx) != 0 {
 			return ErrSwitchPeriodInProcess(keeper.codespace).Result()
 		}
 	}
+	if msg.ProposalType == ProposalTypeSoftwareUpgradeAbort {
+		if upgradeparams.GetCurrentUpgradeProposalId(ctx) == 0 {
+			return ErrNoUpgradeInProcess(keeper.codespace).Result()
+		}
+	}
 	////////////////////  iris end  /////////////////////////////
 
 	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), msg.Proposer, msg.InitialDeposit)
@@ -49,7 +64,7 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 
 	var paramBytes []byte
 	if msg.ProposalType == ProposalTypeParameterChange {
-		paramBytes, _ = json.Marshal(proposal.(*ParameterProposal).Param)
+		paramBytes, _ = json.Marshal(proposal.(*ParameterProposal).Params)
 	}
 	////////////////////  iris end  /////////////////////////////
 	resTags := sdk.NewTags(
@@ -71,6 +86,74 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 	}
 }
 
+func handleMsgSubmitTaxUsageProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitTaxUsageProposal) sdk.Result {
+	if msg.TaxUsage.Usage == UsageTypeGrant {
+		if _, found := keeper.gk.GetTrustee(ctx, msg.TaxUsage.DestAddress); !found {
+			return ErrNotTrustee(keeper.codespace, msg.TaxUsage.DestAddress).Result()
+		}
+	}
+
+	proposal := keeper.NewTaxUsageProposal(ctx, msg.Title, msg.Description, msg.TaxUsage)
+	proposal.SetProposer(msg.Proposer)
+	keeper.SetProposal(ctx, proposal)
+
+	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), msg.Proposer, msg.InitialDeposit)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposalIDBytes := []byte(strconv.FormatUint(proposal.GetProposalID(), 10))
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionSubmitProposal,
+		tags.Proposer, []byte(msg.Proposer.String()),
+		tags.ProposalID, proposalIDBytes,
+		tags.Usage, []byte(msg.TaxUsage.Usage.String()),
+	)
+
+	if votingStarted {
+		resTags = resTags.AppendTag(tags.VotingPeriodStart, proposalIDBytes)
+	}
+
+	return sdk.Result{
+		Data: proposalIDBytes,
+		Tags: resTags,
+	}
+}
+
+func handleMsgSubmitSoftwareUpgradeProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitSoftwareUpgradeProposal) sdk.Result {
+	if upgradeparams.GetCurrentUpgradeProposalId(ctx) != 0 {
+		return ErrSwitchPeriodInProcess(keeper.codespace).Result()
+	}
+	if msg.UpgradeConfig.SwitchHeight > 0 && msg.UpgradeConfig.SwitchHeight <= ctx.BlockHeight() {
+		return ErrInvalidUpgradeConfig(keeper.codespace, fmt.Sprintf("switch height %d is not after the current height %d", msg.UpgradeConfig.SwitchHeight, ctx.BlockHeight())).Result()
+	}
+
+	proposal := keeper.NewUpgradeProposal(ctx, msg.Title, msg.Description, msg.ProposalType, msg.UpgradeConfig)
+	proposal.SetProposer(msg.Proposer)
+	keeper.SetProposal(ctx, proposal)
+
+	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), msg.Proposer, msg.InitialDeposit)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposalIDBytes := []byte(strconv.FormatUint(proposal.GetProposalID(), 10))
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionSubmitProposal,
+		tags.Proposer, []byte(msg.Proposer.String()),
+		tags.ProposalID, proposalIDBytes,
+	)
+
+	if votingStarted {
+		resTags = resTags.AppendTag(tags.VotingPeriodStart, proposalIDBytes)
+	}
+
+	return sdk.Result{
+		Data: proposalIDBytes,
+		Tags: resTags,
+	}
+}
+
 func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) sdk.Result {
 
 	err, votingStarted := keeper.AddDeposit(ctx, msg.ProposalID, msg.Depositor, msg.Amount)
@@ -120,6 +203,46 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	}
 }
 
+////////////////////  iris begin  ///////////////////////////
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposalIDBytes := []byte(strconv.FormatUint(msg.ProposalID, 10))
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionVote,
+		tags.Voter, []byte(msg.Voter.String()),
+		tags.ProposalID, proposalIDBytes,
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgCancelProposal(ctx sdk.Context, keeper Keeper, msg MsgCancelProposal) sdk.Result {
+	action, err := keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposalIDBytes := []byte(strconv.FormatUint(msg.ProposalID, 10))
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionProposalCancelled,
+		tags.Proposer, []byte(msg.Proposer.String()),
+		tags.ProposalID, proposalIDBytes,
+		tags.DepositAction, []byte(action),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+////////////////////  iris end  /////////////////////////////
+
 // Called every block, process inflation, update validator set
 func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 
@@ -138,16 +261,20 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(inactiveIterator.Value(), &proposalID)
 		inactiveProposal := keeper.GetProposal(ctx, proposalID)
 		keeper.DeleteProposal(ctx, proposalID)
-		keeper.DeleteDeposits(ctx, proposalID) // delete any associated deposits (burned)
+		////////////////////  iris begin  ///////////////////////////
+		depositAction := govparams.GetDepositPolicy(ctx).FailedDeposit
+		keeper.SettleDeposits(ctx, proposalID, depositAction) // burned, refunded or sent to the community pool
+		////////////////////  iris end  /////////////////////////////
 
 		resTags = resTags.AppendTag(tags.Action, tags.ActionProposalDropped)
 		resTags = resTags.AppendTag(tags.ProposalID, []byte(string(proposalID)))
+		resTags = resTags.AppendTag(tags.DepositAction, []byte(depositAction))
 
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				inactiveProposal.GetProposalID(),
 				inactiveProposal.GetTitle(),
-				govparams.GetDepositProcedure(ctx).MinDeposit,
+				govparams.GetProposalProcedure(ctx, inactiveProposal.GetProposalType().String()).MinDeposit,
 				inactiveProposal.GetTotalDeposit(),
 			),
 		)
@@ -159,16 +286,27 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		var proposalID uint64
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
 		activeProposal := keeper.GetProposal(ctx, proposalID)
-		passes, tallyResults := tally(ctx, keeper, activeProposal)
+		passes, vetoed, tallyResults := tally(ctx, keeper, activeProposal)
 
 		var action []byte
+		depositAction := govparams.DepositActionRefund
 		if passes {
 			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
 			activeProposal.SetStatus(StatusPassed)
 			action = tags.ActionProposalPassed
-			activeProposal.Execute(ctx,keeper)
+			if err := activeProposal.Execute(ctx, keeper); err != nil {
+				action = tags.ActionProposalExecuteFailed
+				logger.Error(fmt.Sprintf("proposal %d (%s) passed but failed to execute: %s",
+					activeProposal.GetProposalID(), activeProposal.GetTitle(), err.Error()))
+			}
 		} else {
-			keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
+			////////////////////  iris begin  ///////////////////////////
+			depositAction = govparams.GetDepositPolicy(ctx).Rejected
+			if vetoed {
+				depositAction = govparams.GetDepositPolicy(ctx).Vetoed
+			}
+			keeper.SettleDeposits(ctx, activeProposal.GetProposalID(), depositAction)
+			////////////////////  iris end  /////////////////////////////
 			activeProposal.SetStatus(StatusRejected)
 			action = tags.ActionProposalRejected
 		}
@@ -182,6 +320,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 
 		resTags = resTags.AppendTag(tags.Action, action)
 		resTags = resTags.AppendTag(tags.ProposalID, []byte(string(proposalID)))
+		resTags = resTags.AppendTag(tags.DepositAction, []byte(depositAction))
 	}
 	activeIterator.Close()
 
@@ -4,10 +4,14 @@ import (
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/distribution"
+	distrtypes "github.com/irisnet/irishub/modules/distribution/types"
+	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/modules/gov/params"
 	"github.com/tendermint/tendermint/crypto"
 	"time"
 	"github.com/irisnet/irishub/modules/params"
+	"github.com/irisnet/irishub/modules/upgrade/params"
 )
 
 // nolint
@@ -22,6 +26,12 @@ type Keeper struct {
 	// The reference to the CoinKeeper to modify balances
 	ck bank.Keeper
 
+	// The reference to the distribution Keeper to spend the community pool
+	dk distribution.Keeper
+
+	// The reference to the guardian Keeper to check the trustees
+	gk guardian.Keeper
+
 	// The ValidatorSet to get information about validators
 	vs sdk.ValidatorSet
 
@@ -43,10 +53,13 @@ type Keeper struct {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ck bank.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
+// - and spending the community pool by governance.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ck bank.Keeper, dk distribution.Keeper, gk guardian.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
 		storeKey:  key,
 		ck:        ck,
+		dk:        dk,
+		gk:        gk,
 		ds:        ds,
 		vs:        ds.GetValidatorSet(),
 		cdc:       cdc,
@@ -58,16 +71,18 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ck bank.Keeper, ds sdk.Delega
 // Proposals
 
 ////////////////////  iris begin  ///////////////////////////
-func (keeper Keeper) NewProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, param Param) Proposal {
+func (keeper Keeper) NewProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, params Params) Proposal {
 	switch proposalType {
 	case ProposalTypeText:
 		return keeper.NewTextProposal(ctx, title, description, proposalType)
 	case ProposalTypeParameterChange:
-		return keeper.NewParametersProposal(ctx, title, description, proposalType, param)
+		return keeper.NewParametersProposal(ctx, title, description, proposalType, params)
 	case ProposalTypeSoftwareUpgrade:
-		return keeper.NewUpgradeProposal(ctx, title, description, proposalType)
+		return keeper.NewUpgradeProposal(ctx, title, description, proposalType, upgradeparams.UpgradeConfig{})
 	case ProposalTypeTerminator:
 		return keeper.NewTerminatorProposal(ctx, title, description, proposalType)
+	case ProposalTypeSoftwareUpgradeAbort:
+		return keeper.NewUpgradeAbortProposal(ctx, title, description, proposalType)
 	}
 	return nil
 }
@@ -102,7 +117,7 @@ func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description
 }
 
 ////////////////////  iris begin  ///////////////////////////
-func (keeper Keeper) NewParametersProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, param Param) Proposal {
+func (keeper Keeper) NewParametersProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, changes Params) Proposal {
 	proposalID, err := keeper.getNewProposalID(ctx)
 	if err != nil {
 		return nil
@@ -118,11 +133,16 @@ func (keeper Keeper) NewParametersProposal(ctx sdk.Context, title string, descri
 		SubmitTime:   ctx.BlockHeader().Time,
 	}
 
-	param.Value = params.ParamMapping[param.Key].ToJson(param.Value)
+	// normalize the updated values, the inserted elements are kept as they are
+	for i, param := range changes {
+		if param.Op == Update {
+			changes[i].Value = params.ParamMapping[param.Key].ToJson(param.Value)
+		}
+	}
 
 	var proposal Proposal = &ParameterProposal{
 		textProposal,
-		param,
+		changes,
 	}
 
 	depositPeriod := govparams.GetDepositProcedure(ctx).MaxDepositPeriod
@@ -132,7 +152,7 @@ func (keeper Keeper) NewParametersProposal(ctx sdk.Context, title string, descri
 	return proposal
 }
 
-func (keeper Keeper) NewUpgradeProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind) Proposal {
+func (keeper Keeper) NewUpgradeProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, config upgradeparams.UpgradeConfig) Proposal {
 	proposalID, err := keeper.getNewProposalID(ctx)
 	if err != nil {
 		return nil
@@ -149,6 +169,60 @@ func (keeper Keeper) NewUpgradeProposal(ctx sdk.Context, title string, descripti
 	}
 	var proposal Proposal = &SoftwareUpgradeProposal{
 		textProposal,
+		config,
+	}
+
+	depositPeriod := govparams.GetDepositProcedure(ctx).MaxDepositPeriod
+	proposal.SetDepositEndTime(proposal.GetSubmitTime().Add(depositPeriod))
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposalID)
+	return proposal
+}
+
+func (keeper Keeper) NewTaxUsageProposal(ctx sdk.Context, title string, description string, usage TaxUsage) Proposal {
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var textProposal = TextProposal{
+		ProposalID:   proposalID,
+		Title:        title,
+		Description:  description,
+		ProposalType: ProposalTypeCommunityTaxUsage,
+		Status:       StatusDepositPeriod,
+		TallyResult:  EmptyTallyResult(),
+		TotalDeposit: sdk.Coins{},
+		SubmitTime:   ctx.BlockHeader().Time,
+	}
+	var proposal Proposal = &CommunityTaxUsageProposal{
+		textProposal,
+		usage,
+	}
+
+	depositPeriod := govparams.GetDepositProcedure(ctx).MaxDepositPeriod
+	proposal.SetDepositEndTime(proposal.GetSubmitTime().Add(depositPeriod))
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposalID)
+	return proposal
+}
+
+func (keeper Keeper) NewUpgradeAbortProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind) Proposal {
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var textProposal = TextProposal{
+		ProposalID:   proposalID,
+		Title:        title,
+		Description:  description,
+		ProposalType: proposalType,
+		Status:       StatusDepositPeriod,
+		TallyResult:  EmptyTallyResult(),
+		TotalDeposit: sdk.Coins{},
+		SubmitTime:   ctx.BlockHeader().Time,
+	}
+	var proposal Proposal = &SoftwareUpgradeAbortProposal{
+		textProposal,
 	}
 
 	depositPeriod := govparams.GetDepositProcedure(ctx).MaxDepositPeriod
@@ -308,7 +382,7 @@ func (keeper Keeper) peekCurrentProposalID(ctx sdk.Context) (proposalID uint64,
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.SetVotingStartTime(ctx.BlockHeader().Time)
-	votingPeriod := govparams.GetVotingProcedure(ctx).VotingPeriod
+	votingPeriod := govparams.GetProposalProcedure(ctx, proposal.GetProposalType().String()).VotingPeriod
 	proposal.SetVotingEndTime(proposal.GetVotingStartTime().Add(votingPeriod))
 	proposal.SetStatus(StatusVotingPeriod)
 	keeper.SetProposal(ctx, proposal)
@@ -322,6 +396,13 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 
 // Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, NewNonSplitVoteOption(option))
+}
+
+////////////////////  iris begin  ///////////////////////////
+// Adds a vote splitting the voting power of the voter across options.
+// A previous vote of the voter is replaced and the change is kept as its audit trail.
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
 	proposal := keeper.GetProposal(ctx, proposalID)
 	if proposal == nil {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -330,20 +411,35 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !validVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	if err := options.ValidateBasic(); err != nil {
+		return err
 	}
 
 	vote := Vote{
 		ProposalID: proposalID,
 		Voter:      voterAddr,
-		Option:     option,
+		Options:    options,
+	}
+	if len(options) == 1 {
+		vote.Option = options[0].Option
+	}
+
+	if prev, found := keeper.GetVote(ctx, proposalID, voterAddr); found {
+		keeper.addVoteChange(ctx, VoteChange{
+			ProposalID: proposalID,
+			Voter:      voterAddr,
+			Height:     ctx.BlockHeight(),
+			From:       prev.WeightedOptions(),
+			To:         options,
+		})
 	}
 	keeper.setVote(ctx, proposalID, voterAddr, vote)
 
 	return nil
 }
 
+////////////////////  iris end  /////////////////////////////
+
 // Gets the vote of a specific voter on a specific proposal
 func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (Vote, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -368,11 +464,37 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID uint64) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, KeyVotesSubspace(proposalID))
 }
 
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+////////////////////  iris begin  ///////////////////////////
+// Gets the vote changes of a specific voter on a specific proposal, the earliest first
+func (keeper Keeper) GetVoteChanges(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (changes []VoteChange) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyVoteChanges(proposalID, voterAddr))
+	if bz == nil {
+		return nil
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &changes)
+	return changes
+}
+
+func (keeper Keeper) setVoteChanges(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, changes []VoteChange) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(KeyVote(proposalID, voterAddr))
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(changes)
+	store.Set(KeyVoteChanges(proposalID, voterAddr), bz)
 }
 
+func (keeper Keeper) addVoteChange(ctx sdk.Context, change VoteChange) {
+	changes := keeper.GetVoteChanges(ctx, change.ProposalID, change.Voter)
+	keeper.setVoteChanges(ctx, change.ProposalID, change.Voter, append(changes, change))
+}
+
+// Gets the vote changes of all the voters on a specific proposal
+func (keeper Keeper) GetVoteChangesIterator(ctx sdk.Context, proposalID uint64) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return sdk.KVStorePrefixIterator(store, KeyVoteChangesSubspace(proposalID))
+}
+
+////////////////////  iris end  /////////////////////////////
+
 // =====================================================
 // Deposits
 
@@ -421,7 +543,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit tipped proposal into voting period
 	// Active voting period if so
 	activatedVotingPeriod := false
-	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsAllGTE(govparams.GetDepositProcedure(ctx).MinDeposit) {
+	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsAllGTE(govparams.GetProposalProcedure(ctx, proposal.GetProposalType().String()).MinDeposit) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -485,6 +607,72 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	depositsIterator.Close()
 }
 
+////////////////////  iris begin  ///////////////////////////
+// Settles all the deposits on a specific proposal as the deposit policy says
+func (keeper Keeper) SettleDeposits(ctx sdk.Context, proposalID uint64, action govparams.DepositAction) {
+	switch action {
+	case govparams.DepositActionRefund:
+		keeper.RefundDeposits(ctx, proposalID)
+	case govparams.DepositActionCommunityPool:
+		keeper.fundCommunityPool(ctx, proposalID)
+	default:
+		keeper.DeleteDeposits(ctx, proposalID)
+	}
+}
+
+// Deletes all the deposits on a specific proposal and adds them to the community pool,
+// the coins of the pool are not held by any account
+func (keeper Keeper) fundCommunityPool(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	depositsIterator := keeper.GetDeposits(ctx, proposalID)
+
+	total := sdk.Coins{}
+	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), deposit)
+
+		_, _, err := keeper.ck.SubtractCoins(ctx, DepositedCoinsAccAddr, deposit.Amount)
+		if err != nil {
+			panic("should not happen")
+		}
+		total = total.Plus(deposit.Amount)
+
+		store.Delete(depositsIterator.Key())
+	}
+
+	depositsIterator.Close()
+
+	feePool := keeper.dk.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Plus(distrtypes.NewDecCoins(total))
+	keeper.dk.SetFeePool(ctx, feePool)
+}
+
+// Cancels a proposal in its deposit period on behalf of its proposer, the deposits are settled as
+// the deposit policy says for the cancelled proposals
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) (govparams.DepositAction, sdk.Error) {
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return "", ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	switch proposal.GetStatus() {
+	case StatusDepositPeriod:
+	case StatusVotingPeriod:
+		return "", ErrAlreadyActiveProposal(keeper.codespace, proposalID)
+	default:
+		return "", ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+	if !proposal.GetProposer().Equals(proposer) {
+		return "", ErrNotProposer(keeper.codespace, proposalID, proposer)
+	}
+
+	action := govparams.GetDepositPolicy(ctx).Cancelled
+	keeper.SettleDeposits(ctx, proposalID, action)
+	keeper.DeleteProposal(ctx, proposalID)
+	return action, nil
+}
+
+////////////////////  iris end  /////////////////////////////
+
 // =====================================================
 // ProposalQueues
 
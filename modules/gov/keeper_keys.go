@@ -44,6 +44,16 @@ func KeyVotesSubspace(proposalID uint64) []byte {
 	return []byte(fmt.Sprintf("votes:%d:", proposalID))
 }
 
+// Key for getting the vote changes of a voter on a proposal
+func KeyVoteChanges(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("voteChanges:%d:%d", proposalID, voterAddr))
+}
+
+// Key for getting all vote changes on a proposal from the store
+func KeyVoteChangesSubspace(proposalID uint64) []byte {
+	return []byte(fmt.Sprintf("voteChanges:%d:", proposalID))
+}
+
 // Returns the key for a proposalID in the activeProposalQueue
 func PrefixActiveProposalQueueTime(endTime time.Time) []byte {
 	return bytes.Join([][]byte{
@@ -0,0 +1,96 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/modules/gov/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func irisCoins(amount int64) sdk.Coins {
+	return sdk.Coins{sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(amount, 18))}
+}
+
+func submitTextProposal(t *testing.T, ctx sdk.Context, keeper Keeper, proposer sdk.AccAddress, deposit sdk.Coins) uint64 {
+	res := handleMsgSubmitProposal(ctx, keeper, NewMsgSubmitProposal("Test", "description", ProposalTypeText, proposer, deposit, nil))
+	require.True(t, res.IsOK(), res.Log)
+	return keeper.GetLastProposalID(ctx)
+}
+
+func TestKeeper_CancelProposal(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	proposalID := submitTextProposal(t, ctx, keeper, addrs[0], irisCoins(5))
+	require.Equal(t, StatusDepositPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// only the proposer can cancel a proposal
+	res := handleMsgCancelProposal(ctx, keeper, NewMsgCancelProposal(addrs[1], proposalID))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNotProposer), res.Code)
+	require.NotNil(t, keeper.GetProposal(ctx, proposalID))
+
+	// the proposal is cancelled during its deposit period
+	res = handleMsgCancelProposal(ctx, keeper, NewMsgCancelProposal(addrs[0], proposalID))
+	require.True(t, res.IsOK())
+	require.Nil(t, keeper.GetProposal(ctx, proposalID))
+	_, found := keeper.GetDeposit(ctx, proposalID, addrs[0])
+	require.False(t, found)
+
+	res = handleMsgCancelProposal(ctx, keeper, NewMsgCancelProposal(addrs[0], proposalID))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownProposal), res.Code)
+
+	// a proposal can not be cancelled once its voting period starts
+	proposalID = submitTextProposal(t, ctx, keeper, addrs[0], irisCoins(10))
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+	res = handleMsgCancelProposal(ctx, keeper, NewMsgCancelProposal(addrs[0], proposalID))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeAlreadyActiveProposal), res.Code)
+	require.NotNil(t, keeper.GetProposal(ctx, proposalID))
+}
+
+func TestKeeper_CancelProposalDepositPolicy(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	setCommunityPool(ctx, keeper, sdk.Coins{})
+
+	cancel := func(policy govparams.DepositAction) {
+		govparams.DepositPolicyParameter.Value.Cancelled = policy
+		govparams.DepositPolicyParameter.SaveValue(ctx)
+
+		proposalID := submitTextProposal(t, ctx, keeper, addrs[0], irisCoins(3))
+		err, _ := keeper.AddDeposit(ctx, proposalID, addrs[1], irisCoins(2))
+		require.Nil(t, err)
+		action, err := keeper.CancelProposal(ctx, proposalID, addrs[0])
+		require.Nil(t, err)
+		require.Equal(t, policy, action)
+		require.True(t, keeper.ck.GetCoins(ctx, DepositedCoinsAccAddr).IsZero())
+	}
+
+	coins0 := keeper.ck.GetCoins(ctx, addrs[0])
+	coins1 := keeper.ck.GetCoins(ctx, addrs[1])
+
+	// burn
+	cancel(govparams.DepositActionBurn)
+	require.True(t, keeper.ck.GetCoins(ctx, BurnedDepositCoinsAccAddr).IsEqual(irisCoins(5)))
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[0]).IsEqual(coins0.Minus(irisCoins(3))))
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[1]).IsEqual(coins1.Minus(irisCoins(2))))
+
+	// refund
+	coins0 = keeper.ck.GetCoins(ctx, addrs[0])
+	coins1 = keeper.ck.GetCoins(ctx, addrs[1])
+	cancel(govparams.DepositActionRefund)
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[0]).IsEqual(coins0))
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[1]).IsEqual(coins1))
+	require.True(t, keeper.ck.GetCoins(ctx, BurnedDepositCoinsAccAddr).IsEqual(irisCoins(5)))
+
+	// community pool
+	cancel(govparams.DepositActionCommunityPool)
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[0]).IsEqual(coins0.Minus(irisCoins(3))))
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[1]).IsEqual(coins1.Minus(irisCoins(2))))
+	require.True(t, keeper.ck.GetCoins(ctx, BurnedDepositCoinsAccAddr).IsEqual(irisCoins(5)))
+	communityPool := keeper.dk.GetFeePool(ctx).CommunityPool.AmountOf("iris-atto")
+	require.True(t, communityPool.Equal(sdk.NewDecFromInt(sdk.NewIntWithDecimal(5, 18))))
+}
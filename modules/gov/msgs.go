@@ -3,14 +3,14 @@ package gov
 import (
 	"fmt"
 
+	"github.com/irisnet/irishub/modules/upgrade/params"
 	sdk "github.com/irisnet/irishub/types"
-	"github.com/irisnet/irishub/modules/params"
 )
 
 // name to idetify transaction types
 const MsgRoute = "gov"
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgSubmitTaxUsageProposal{}, MsgSubmitSoftwareUpgradeProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{}
 
 //-----------------------------------------------------------
 // MsgSubmitProposal
@@ -21,11 +21,11 @@ type MsgSubmitProposal struct {
 	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
 	////////////////////  iris begin  ///////////////////////////
-	Param          Param
+	Params         Params         `json:"params"`          //  Parameter changes of a ParameterChange proposal
 	////////////////////  iris end  /////////////////////////////
 }
 
-func NewMsgSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins, param Param) MsgSubmitProposal {
+func NewMsgSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins, params Params) MsgSubmitProposal {
 	return MsgSubmitProposal{
 		Title:          title,
 		Description:    description,
@@ -33,7 +33,7 @@ func NewMsgSubmitProposal(title string, description string, proposalType Proposa
 		Proposer:       proposer,
 		InitialDeposit: initialDeposit,
 		////////////////////  iris begin  ///////////////////////////
-		Param:          param,
+		Params:         params,
 		////////////////////  iris end  /////////////////////////////
 	}
 }
@@ -64,16 +64,11 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	}
 	////////////////////  iris begin  ///////////////////////////
 	if msg.ProposalType == ProposalTypeParameterChange {
-
-		if msg.Param.Op != Update && msg.Param.Op != Insert {
-			return ErrInvalidParamOp(DefaultCodespace, msg.Param.Op)
-		}
-
-		if p, ok := params.ParamMapping[msg.Param.Key]; ok {
-			return p.Valid(msg.Param.Value)
-		} else {
-			return ErrInvalidParam(DefaultCodespace)
-		}
+		return msg.Params.Validate()
+	}
+	// the community pool is only spent by MsgSubmitTaxUsageProposal
+	if msg.ProposalType == ProposalTypeCommunityTaxUsage {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
 	}
 	////////////////////  iris end  /////////////////////////////
 	return nil
@@ -102,6 +97,96 @@ func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Proposer}
 }
 
+//-----------------------------------------------------------
+// MsgSubmitTaxUsageProposal
+type MsgSubmitTaxUsageProposal struct {
+	MsgSubmitProposal
+	TaxUsage TaxUsage `json:"tax_usage"` //  How the community pool is spent
+}
+
+func NewMsgSubmitTaxUsageProposal(msgSubmitProposal MsgSubmitProposal, usage TaxUsage) MsgSubmitTaxUsageProposal {
+	return MsgSubmitTaxUsageProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		TaxUsage:          usage,
+	}
+}
+
+//nolint
+func (msg MsgSubmitTaxUsageProposal) Route() string { return MsgRoute }
+func (msg MsgSubmitTaxUsageProposal) Type() string  { return "submit_tax_usage_proposal" }
+
+// Implements Msg.
+func (msg MsgSubmitTaxUsageProposal) ValidateBasic() sdk.Error {
+	if msg.ProposalType != ProposalTypeCommunityTaxUsage {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	// validate the embedded proposal as a text one, MsgSubmitProposal rejects the tax usage type
+	textMsg := msg.MsgSubmitProposal
+	textMsg.ProposalType = ProposalTypeText
+	if err := textMsg.ValidateBasic(); err != nil {
+		return err
+	}
+	return msg.TaxUsage.ValidateBasic()
+}
+
+func (msg MsgSubmitTaxUsageProposal) String() string {
+	return fmt.Sprintf("MsgSubmitTaxUsageProposal{%s, %s, %s, %v, %s, %s}", msg.Title, msg.Description,
+		msg.ProposalType, msg.InitialDeposit, msg.TaxUsage.Usage, msg.TaxUsage.Percent)
+}
+
+// Implements Msg.
+func (msg MsgSubmitTaxUsageProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+//-----------------------------------------------------------
+// MsgSubmitSoftwareUpgradeProposal
+type MsgSubmitSoftwareUpgradeProposal struct {
+	MsgSubmitProposal
+	UpgradeConfig upgradeparams.UpgradeConfig `json:"upgrade_config"` //  The software the network switches to
+}
+
+func NewMsgSubmitSoftwareUpgradeProposal(msgSubmitProposal MsgSubmitProposal, config upgradeparams.UpgradeConfig) MsgSubmitSoftwareUpgradeProposal {
+	return MsgSubmitSoftwareUpgradeProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		UpgradeConfig:     config,
+	}
+}
+
+//nolint
+func (msg MsgSubmitSoftwareUpgradeProposal) Route() string { return MsgRoute }
+func (msg MsgSubmitSoftwareUpgradeProposal) Type() string  { return "submit_software_upgrade_proposal" }
+
+// Implements Msg.
+func (msg MsgSubmitSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if msg.ProposalType != ProposalTypeSoftwareUpgrade {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	if err := msg.MsgSubmitProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return validateUpgradeConfig(msg.UpgradeConfig)
+}
+
+func (msg MsgSubmitSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf("MsgSubmitSoftwareUpgradeProposal{%s, %s, %s, %v, %d, %s, %d, %s}", msg.Title, msg.Description,
+		msg.ProposalType, msg.InitialDeposit, msg.UpgradeConfig.ProtocolVersion, msg.UpgradeConfig.Software,
+		msg.UpgradeConfig.SwitchHeight, msg.UpgradeConfig.Checksum)
+}
+
+// Implements Msg.
+func (msg MsgSubmitSoftwareUpgradeProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
 //-----------------------------------------------------------
 // MsgDeposit
 type MsgDeposit struct {
@@ -220,3 +305,105 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+//-----------------------------------------------------------
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  options with the share of voting power given to each
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return MsgRoute }
+func (msg MsgVoteWeighted) Type() string  { return "vote_weighted" }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if len(msg.Voter.Bytes()) == 0 {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	return msg.Options.ValidateBasic()
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf("MsgVoteWeighted{%v - %s}", msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) Get(key interface{}) (value interface{}) {
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+//-----------------------------------------------------------
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer"`    //  Address of the proposer
+}
+
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{
+		ProposalID: proposalID,
+		Proposer:   proposer,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return MsgRoute }
+func (msg MsgCancelProposal) Type() string  { return "cancel_proposal" }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if len(msg.Proposer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf("MsgCancelProposal{%s, %v}", msg.Proposer, msg.ProposalID)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) Get(key interface{}) (value interface{}) {
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
@@ -0,0 +1,115 @@
+package govparams
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var DepositPolicyParameter DepositPolicyParam
+var _ params.GovParameter = (*DepositPolicyParam)(nil)
+
+// DepositAction - what happens to the deposits of a proposal
+type DepositAction string
+
+const (
+	DepositActionBurn          DepositAction = "Burn"          // the deposits are sent to the burned deposit account
+	DepositActionRefund        DepositAction = "Refund"        // the deposits are returned to the depositors
+	DepositActionCommunityPool DepositAction = "CommunityPool" // the deposits go to the community pool
+)
+
+func validDepositAction(action DepositAction) bool {
+	return action == DepositActionBurn || action == DepositActionRefund || action == DepositActionCommunityPool
+}
+
+// Policy for the deposits of the proposals that don't pass, the deposits of passed proposals are always refunded
+type DepositPolicy struct {
+	FailedDeposit DepositAction `json:"failed_deposit"` //  Proposals that didn't meet the minimum deposit in time
+	Rejected      DepositAction `json:"rejected"`       //  Proposals rejected by the votes
+	Vetoed        DepositAction `json:"vetoed"`         //  Proposals rejected by veto
+	Cancelled     DepositAction `json:"cancelled"`      //  Proposals cancelled by their proposer during the deposit period
+}
+
+func (dp DepositPolicy) Validate() sdk.Error {
+	for _, action := range []DepositAction{dp.FailedDeposit, dp.Rejected, dp.Vetoed, dp.Cancelled} {
+		if !validDepositAction(action) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidDepositPolicy, fmt.Sprintf("Invalid deposit action ( %s ) should be one of Burn, Refund and CommunityPool", action))
+		}
+	}
+	return nil
+}
+
+type DepositPolicyParam struct {
+	Value      DepositPolicy
+	paramSpace params.Subspace
+}
+
+func (param *DepositPolicyParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *DepositPolicyParam) InitGenesis(genesisState interface{}) {
+	if value, ok := genesisState.(DepositPolicy); ok {
+		param.Value = value
+	} else {
+		param.Value = DepositPolicy{
+			FailedDeposit: DepositActionBurn,
+			Rejected:      DepositActionBurn,
+			Vetoed:        DepositActionBurn,
+			Cancelled:     DepositActionRefund,
+		}
+	}
+}
+
+func (param *DepositPolicyParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *DepositPolicyParam) GetStoreKey() []byte {
+	return []byte("govDepositPolicy")
+}
+
+func (param *DepositPolicyParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *DepositPolicyParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+func (param *DepositPolicyParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *DepositPolicyParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *DepositPolicyParam) Valid(jsonStr string) sdk.Error {
+	var policy DepositPolicy
+	if err := json.Unmarshal([]byte(jsonStr), &policy); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidDepositPolicy, fmt.Sprintf("Json is not valid"))
+	}
+	return policy.Validate()
+}
@@ -0,0 +1,42 @@
+package govparams
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositPolicyParameter(t *testing.T) {
+	skey := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	ctx := defaultContext(skey, tkeyParams)
+	cdc := codec.New()
+
+	paramKeeper := params.NewKeeper(
+		cdc,
+		skey, tkeyParams,
+	)
+
+	subspace := paramKeeper.Subspace("Gov").WithTypeTable(
+		params.NewTypeTable(
+			DepositPolicyParameter.GetStoreKey(), DepositPolicy{},
+		))
+	params.SetParamReadWriter(subspace, &DepositPolicyParameter)
+	params.InitGenesisParameter(&DepositPolicyParameter, ctx, nil)
+
+	require.Equal(t, "{\"failed_deposit\":\"Burn\",\"rejected\":\"Burn\",\"vetoed\":\"Burn\",\"cancelled\":\"Refund\"}", DepositPolicyParameter.ToJson(""))
+
+	policy := "{\"failed_deposit\":\"Burn\",\"rejected\":\"Refund\",\"vetoed\":\"CommunityPool\",\"cancelled\":\"Refund\"}"
+	require.Nil(t, DepositPolicyParameter.Valid(policy))
+	DepositPolicyParameter.Update(ctx, policy)
+	require.Equal(t, DepositActionCommunityPool, GetDepositPolicy(ctx).Vetoed)
+	require.Equal(t, DepositActionRefund, GetDepositPolicy(ctx).Rejected)
+
+	require.NotNil(t, DepositPolicyParameter.Valid("{\"failed_deposit\":\"Burn\",\"rejected\":\"Keep\",\"vetoed\":\"Burn\",\"cancelled\":\"Refund\"}"))
+	require.NotNil(t, DepositPolicyParameter.Valid("{\"failed_deposit\":\"Burn\"}"))
+	require.NotNil(t, DepositPolicyParameter.Valid("Burn"))
+}
@@ -19,9 +19,11 @@ const THREE_DAYS = 3*3600*24
 var _ params.GovParameter = (*DepositProcedureParam)(nil)
 
 type ParamSet struct {
-	DepositProcedure  DepositProcedure  `json:"Gov/govDepositProcedure"`
-	VotingProcedure   VotingProcedure   `json:"Gov/govVotingProcedure"`
-	TallyingProcedure TallyingProcedure `json:"Gov/govTallyingProcedure"`
+	DepositProcedure   DepositProcedure   `json:"Gov/govDepositProcedure"`
+	VotingProcedure    VotingProcedure    `json:"Gov/govVotingProcedure"`
+	TallyingProcedure  TallyingProcedure  `json:"Gov/govTallyingProcedure"`
+	ProposalProcedures ProposalProcedures `json:"Gov/govProposalProcedures"`
+	DepositPolicy      DepositPolicy      `json:"Gov/govDepositPolicy"`
 }
 
 // Procedure around Deposits for governance
@@ -88,9 +90,14 @@ func (param *DepositProcedureParam) ToJson(jsonStr string) string {
 	return string(jsonBytes)
 }
 
+// Update also changes the minimum deposit of every kind of proposal if it changes
 func (param *DepositProcedureParam) Update(ctx sdk.Context, jsonStr string) {
+	prev := GetDepositProcedure(ctx)
 	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
 		param.SaveValue(ctx)
+		if minDeposit := param.Value.MinDeposit; !minDeposit.IsEqual(prev.MinDeposit) {
+			updateProposalProcedures(ctx, func(p *ProposalProcedure) { p.MinDeposit = minDeposit })
+		}
 	}
 }
 
@@ -183,9 +190,14 @@ func (param *VotingProcedureParam) ToJson(jsonStr string) string {
 	return string(jsonBytes)
 }
 
+// Update also changes the voting period of every kind of proposal if it changes
 func (param *VotingProcedureParam) Update(ctx sdk.Context, jsonStr string) {
+	prev := GetVotingProcedure(ctx)
 	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
 		param.SaveValue(ctx)
+		if votingPeriod := param.Value.VotingPeriod; votingPeriod != prev.VotingPeriod {
+			updateProposalProcedures(ctx, func(p *ProposalProcedure) { p.VotingPeriod = votingPeriod })
+		}
 	}
 }
 
@@ -272,9 +284,23 @@ func (param *TallyingProcedureParam) ToJson(jsonStr string) string {
 	return string(jsonBytes)
 }
 
+// Update also changes the threshold, veto or participation of every kind of proposal, those of them that change
 func (param *TallyingProcedureParam) Update(ctx sdk.Context, jsonStr string) {
+	prev := GetTallyingProcedure(ctx)
 	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
 		param.SaveValue(ctx)
+		tp := param.Value
+		updateProposalProcedures(ctx, func(p *ProposalProcedure) {
+			if !tp.Threshold.Equal(prev.Threshold) {
+				p.Threshold = tp.Threshold
+			}
+			if !tp.Veto.Equal(prev.Veto) {
+				p.Veto = tp.Veto
+			}
+			if !tp.Participation.Equal(prev.Participation) {
+				p.Participation = tp.Participation
+			}
+		})
 	}
 }
 
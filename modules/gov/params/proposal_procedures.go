@@ -0,0 +1,240 @@
+package govparams
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	"github.com/irisnet/irishub/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var ProposalProceduresParameter ProposalProceduresParam
+var _ params.GovArrayParameter = (*ProposalProceduresParam)(nil)
+
+// Procedure of a kind of proposal, overriding the global deposit, voting and tallying procedures
+type ProposalProcedure struct {
+	ProposalType  string        `json:"proposal_type"` //  Kind of the proposals, eg. SoftwareUpgrade
+	MinDeposit    sdk.Coins     `json:"min_deposit"`   //  Minimum deposit for a proposal to enter voting period
+	VotingPeriod  time.Duration `json:"voting_period"` //  Length of the voting period
+	Threshold     sdk.Dec       `json:"threshold"`     //  Minimum propotion of Yes votes for proposal to pass
+	Veto          sdk.Dec       `json:"veto"`          //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed
+	Participation sdk.Dec       `json:"participation"` //  Minimum propotion of the voting power voted, the quorum
+}
+
+type ProposalProcedures []ProposalProcedure
+
+// NewProposalProcedures derives the procedures of all the kinds of proposals from the global procedures.
+// A new kind of proposal should come with its procedure here.
+func NewProposalProcedures(dp DepositProcedure, vp VotingProcedure, tp TallyingProcedure) ProposalProcedures {
+	procedure := func(proposalType string) ProposalProcedure {
+		return ProposalProcedure{
+			ProposalType:  proposalType,
+			MinDeposit:    dp.MinDeposit,
+			VotingPeriod:  vp.VotingPeriod,
+			Threshold:     tp.Threshold,
+			Veto:          tp.Veto,
+			Participation: tp.Participation,
+		}
+	}
+	return ProposalProcedures{
+		procedure("Text"),
+		procedure("ParameterChange"),
+		procedure("SoftwareUpgrade"),
+		procedure("Terminator"),
+		procedure("CommunityTaxUsage"),
+		procedure("SoftwareUpgradeAbort"),
+	}
+}
+
+func DefaultProposalProcedures() ProposalProcedures {
+	var dp DepositProcedureParam
+	var vp VotingProcedureParam
+	var tp TallyingProcedureParam
+	dp.InitGenesis(nil)
+	vp.InitGenesis(nil)
+	tp.InitGenesis(nil)
+	return NewProposalProcedures(dp.Value, vp.Value, tp.Value)
+}
+
+// Get the procedure of a kind of proposal
+func (ps ProposalProcedures) Get(proposalType string) (ProposalProcedure, bool) {
+	for _, p := range ps {
+		if p.ProposalType == proposalType {
+			return p, true
+		}
+	}
+	return ProposalProcedure{}, false
+}
+
+func (p ProposalProcedure) Validate() sdk.Error {
+	if _, ok := DefaultProposalProcedures().Get(p.ProposalType); !ok {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalProcedure, fmt.Sprintf("Unknown proposal type '%s'", p.ProposalType))
+	}
+	if len(p.MinDeposit) != 1 || p.MinDeposit[0].Denom != "iris-atto" {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMinDepositDenom, fmt.Sprintf("It should be iris-atto!"))
+	}
+
+	LowerBound, _ := types.NewDefaultCoinType("iris").ConvertToMinCoin(fmt.Sprintf("%d%s", LOWER_BOUND_AMOUNT, "iris"))
+	UpperBound, _ := types.NewDefaultCoinType("iris").ConvertToMinCoin(fmt.Sprintf("%d%s", UPPER_BOUND_AMOUNT, "iris"))
+	if p.MinDeposit[0].Amount.LT(LowerBound.Amount) || p.MinDeposit[0].Amount.GT(UpperBound.Amount) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMinDepositAmount, fmt.Sprintf("MinDepositAmount"+p.MinDeposit[0].String()+" should be larger than 10iris and less than 10000iris"))
+	}
+	if p.VotingPeriod.Seconds() < 20 || p.VotingPeriod.Seconds() > THREE_DAYS {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidVotingPeriod, fmt.Sprintf("VotingPeriod (%s) should be larger than 20s and less than %ds", strconv.Itoa(int(p.VotingPeriod.Seconds())), THREE_DAYS))
+	}
+	if p.Threshold.IsNil() || p.Threshold.LTE(sdk.ZeroDec()) || p.Threshold.GTE(sdk.NewDec(1)) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidThreshold, fmt.Sprintf("Invalid Threshold ( "+p.Threshold.String()+" ) should be between 0 and 1"))
+	}
+	if p.Participation.IsNil() || p.Participation.LTE(sdk.ZeroDec()) || p.Participation.GTE(sdk.NewDec(1)) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidParticipation, fmt.Sprintf("Invalid participation ( "+p.Participation.String()+" ) should be between 0 and 1"))
+	}
+	if p.Veto.IsNil() || p.Veto.LTE(sdk.ZeroDec()) || p.Veto.GTE(sdk.NewDec(1)) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidVeto, fmt.Sprintf("Invalid Veto ( "+p.Veto.String()+" ) should be between 0 and 1"))
+	}
+	return nil
+}
+
+func (ps ProposalProcedures) Validate() sdk.Error {
+	for i, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ps[:i].Get(p.ProposalType); ok {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalProcedure, fmt.Sprintf("Duplicate procedure of proposal type '%s'", p.ProposalType))
+		}
+	}
+	return nil
+}
+
+// ValidateComplete also requires a procedure for every kind of proposal
+func (ps ProposalProcedures) ValidateComplete() sdk.Error {
+	for _, p := range DefaultProposalProcedures() {
+		if _, ok := ps.Get(p.ProposalType); !ok {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalProcedure, fmt.Sprintf("Missing procedure of proposal type '%s'", p.ProposalType))
+		}
+	}
+	return ps.Validate()
+}
+
+// updateProposalProcedures applies a change of a global procedure to the procedures of all the kinds of proposals
+func updateProposalProcedures(ctx sdk.Context, update func(p *ProposalProcedure)) {
+	if !ProposalProceduresParameter.LoadValue(ctx) {
+		return
+	}
+	for i := range ProposalProceduresParameter.Value {
+		update(&ProposalProceduresParameter.Value[i])
+	}
+	ProposalProceduresParameter.SaveValue(ctx)
+}
+
+// The procedures are a list, updated as a whole or inserted one by one,
+// inserting the procedure of a kind of proposal replaces the existing one
+type ProposalProceduresParam struct {
+	Value      ProposalProcedures
+	paramSpace params.Subspace
+}
+
+func (param *ProposalProceduresParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *ProposalProceduresParam) InitGenesis(genesisState interface{}) {
+	if value, ok := genesisState.(ProposalProcedures); ok && len(value) > 0 {
+		param.Value = value
+	} else {
+		param.Value = DefaultProposalProcedures()
+	}
+}
+
+func (param *ProposalProceduresParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *ProposalProceduresParam) GetStoreKey() []byte {
+	return []byte("govProposalProcedures")
+}
+
+func (param *ProposalProceduresParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *ProposalProceduresParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+// LoadValueByKey loads the procedures, returns true if the kind of proposal has its own procedure
+func (param *ProposalProceduresParam) LoadValueByKey(ctx sdk.Context, key string) bool {
+	if !param.LoadValue(ctx) {
+		return false
+	}
+	_, ok := param.Value.Get(key)
+	return ok
+}
+
+func (param *ProposalProceduresParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+// Update replaces the whole list, it panics on a value that Valid rejects
+func (param *ProposalProceduresParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err != nil {
+		panic(err)
+	}
+	param.SaveValue(ctx)
+}
+
+func (param *ProposalProceduresParam) Insert(ctx sdk.Context, jsonStr string) sdk.Error {
+	var procedure ProposalProcedure
+	if err := json.Unmarshal([]byte(jsonStr), &procedure); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalProcedure, fmt.Sprintf("Json is not valid"))
+	}
+	param.LoadValue(ctx)
+	for i, p := range param.Value {
+		if p.ProposalType == procedure.ProposalType {
+			param.Value[i] = procedure
+			param.SaveValue(ctx)
+			return nil
+		}
+	}
+	param.Value = append(param.Value, procedure)
+	param.SaveValue(ctx)
+	return nil
+}
+
+// Valid checks the whole list of an update, it must keep a procedure for every kind of proposal
+func (param *ProposalProceduresParam) Valid(jsonStr string) sdk.Error {
+	var procedures ProposalProcedures
+	if err := json.Unmarshal([]byte(jsonStr), &procedures); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalProcedure, fmt.Sprintf("Json is not valid"))
+	}
+	return procedures.ValidateComplete()
+}
+
+// ValidInsert checks the single procedure of an insert
+func (param *ProposalProceduresParam) ValidInsert(jsonStr string) sdk.Error {
+	var procedure ProposalProcedure
+	if err := json.Unmarshal([]byte(jsonStr), &procedure); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalProcedure, fmt.Sprintf("Json is not valid"))
+	}
+	return procedure.Validate()
+}
@@ -0,0 +1,140 @@
+package govparams
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalProceduresParameter(t *testing.T) {
+	skey := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	ctx := defaultContext(skey, tkeyParams)
+	cdc := codec.New()
+
+	paramKeeper := params.NewKeeper(
+		cdc,
+		skey, tkeyParams,
+	)
+
+	subspace := paramKeeper.Subspace("Gov").WithTypeTable(
+		params.NewTypeTable(
+			DepositProcedureParameter.GetStoreKey(), DepositProcedure{},
+			VotingProcedureParameter.GetStoreKey(), VotingProcedure{},
+			TallyingProcedureParameter.GetStoreKey(), TallyingProcedure{},
+			ProposalProceduresParameter.GetStoreKey(), ProposalProcedures{},
+		))
+	params.SetParamReadWriter(subspace, &DepositProcedureParameter, &VotingProcedureParameter,
+		&TallyingProcedureParameter, &ProposalProceduresParameter)
+	params.InitGenesisParameter(&DepositProcedureParameter, ctx, nil)
+	params.InitGenesisParameter(&VotingProcedureParameter, ctx, nil)
+	params.InitGenesisParameter(&TallyingProcedureParameter, ctx, nil)
+	params.InitGenesisParameter(&ProposalProceduresParameter, ctx, nil)
+
+	require.Equal(t, DefaultProposalProcedures(), ProposalProceduresParameter.Value)
+	require.Nil(t, ProposalProceduresParameter.Value.Validate())
+
+	// every kind of proposal starts with the global procedures
+	upgrade := GetProposalProcedure(ctx, "SoftwareUpgrade")
+	require.Equal(t, GetTallyingProcedure(ctx).Threshold, upgrade.Threshold)
+	require.Equal(t, GetTallyingProcedure(ctx).Threshold, GetProposalProcedure(ctx, "Text").Threshold)
+
+	// a single procedure is inserted, replacing the one of its kind
+	upgrade.Threshold = sdk.NewDecWithPrec(8, 1)
+	upgradeJson := ProposalProceduresParameter.ToJson("")
+	bz, err := cdc.MarshalJSON(upgrade)
+	require.Nil(t, err)
+	require.Nil(t, ProposalProceduresParameter.ValidInsert(string(bz)))
+	require.NotNil(t, ProposalProceduresParameter.Valid(string(bz)))
+	require.Nil(t, ProposalProceduresParameter.Insert(ctx, string(bz)))
+	require.Equal(t, sdk.NewDecWithPrec(8, 1), GetProposalProcedure(ctx, "SoftwareUpgrade").Threshold)
+	require.Equal(t, len(DefaultProposalProcedures()), len(ProposalProceduresParameter.Value))
+
+	// the whole list is updated, keeping a procedure for every kind of proposal
+	require.Nil(t, ProposalProceduresParameter.Valid(upgradeJson))
+	require.NotNil(t, ProposalProceduresParameter.ValidInsert(upgradeJson))
+	ProposalProceduresParameter.Update(ctx, upgradeJson)
+	require.Equal(t, GetTallyingProcedure(ctx).Threshold, GetProposalProcedure(ctx, "SoftwareUpgrade").Threshold)
+	bz, _ = cdc.MarshalJSON(DefaultProposalProcedures()[1:])
+	require.NotNil(t, ProposalProceduresParameter.Valid(string(bz)))
+	require.Panics(t, func() { ProposalProceduresParameter.Update(ctx, string(bz[1:])) })
+
+	// unknown kinds, duplicate kinds and invalid thresholds are rejected
+	unknown := upgrade
+	unknown.ProposalType = "Unknown"
+	bz, _ = cdc.MarshalJSON(unknown)
+	require.NotNil(t, ProposalProceduresParameter.ValidInsert(string(bz)))
+
+	bz, _ = cdc.MarshalJSON(append(DefaultProposalProcedures(), upgrade))
+	require.NotNil(t, ProposalProceduresParameter.Valid(string(bz)))
+
+	invalid := upgrade
+	invalid.Threshold = sdk.NewDec(1)
+	bz, _ = cdc.MarshalJSON(invalid)
+	require.NotNil(t, ProposalProceduresParameter.ValidInsert(string(bz)))
+}
+
+func TestProposalProcedures_GlobalProcedures(t *testing.T) {
+	skey := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	ctx := defaultContext(skey, tkeyParams)
+	paramKeeper := params.NewKeeper(codec.New(), skey, tkeyParams)
+	subspace := paramKeeper.Subspace("Gov").WithTypeTable(
+		params.NewTypeTable(
+			DepositProcedureParameter.GetStoreKey(), DepositProcedure{},
+			VotingProcedureParameter.GetStoreKey(), VotingProcedure{},
+			TallyingProcedureParameter.GetStoreKey(), TallyingProcedure{},
+			ProposalProceduresParameter.GetStoreKey(), ProposalProcedures{},
+		))
+	params.SetParamReadWriter(subspace, &DepositProcedureParameter, &VotingProcedureParameter,
+		&TallyingProcedureParameter, &ProposalProceduresParameter)
+	params.InitGenesisParameter(&DepositProcedureParameter, ctx, nil)
+	params.InitGenesisParameter(&VotingProcedureParameter, ctx, nil)
+	params.InitGenesisParameter(&TallyingProcedureParameter, ctx, nil)
+	params.InitGenesisParameter(&ProposalProceduresParameter, ctx, nil)
+
+	text := GetProposalProcedure(ctx, "Text")
+	text.VotingPeriod = 60 * time.Second
+	text.Threshold = sdk.NewDecWithPrec(6, 1)
+	bz, _ := json.Marshal(text)
+	require.Nil(t, ProposalProceduresParameter.Insert(ctx, string(bz)))
+
+	// changing a global procedure applies what changed to every kind of proposal
+	tallyingProcedure := GetTallyingProcedure(ctx)
+	tallyingProcedure.Veto = sdk.NewDecWithPrec(4, 1)
+	bz, _ = json.Marshal(tallyingProcedure)
+	require.Nil(t, TallyingProcedureParameter.Valid(string(bz)))
+	TallyingProcedureParameter.Update(ctx, string(bz))
+	for _, procedure := range ProposalProceduresParameter.Value {
+		require.True(t, procedure.Veto.Equal(sdk.NewDecWithPrec(4, 1)))
+	}
+	require.True(t, GetProposalProcedure(ctx, "Text").Threshold.Equal(sdk.NewDecWithPrec(6, 1)))
+	require.True(t, GetProposalProcedure(ctx, "SoftwareUpgrade").Threshold.Equal(tallyingProcedure.Threshold))
+
+	votingProcedure := VotingProcedure{VotingPeriod: 120 * time.Second}
+	bz, _ = json.Marshal(votingProcedure)
+	VotingProcedureParameter.Update(ctx, string(bz))
+	require.Equal(t, 120*time.Second, GetProposalProcedure(ctx, "Text").VotingPeriod)
+	require.Equal(t, 120*time.Second, GetProposalProcedure(ctx, "SoftwareUpgrade").VotingPeriod)
+
+	// the other fields of the procedures are kept
+	depositProcedure := GetDepositProcedure(ctx)
+	depositProcedure.MaxDepositPeriod = 60 * time.Second
+	bz, _ = json.Marshal(depositProcedure)
+	DepositProcedureParameter.Update(ctx, string(bz))
+	require.Equal(t, 120*time.Second, GetProposalProcedure(ctx, "Text").VotingPeriod)
+
+	minDeposit := sdk.Coins{sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(20, 18))}
+	depositProcedure.MinDeposit = minDeposit
+	bz, _ = json.Marshal(depositProcedure)
+	DepositProcedureParameter.Update(ctx, string(bz))
+	require.True(t, GetProposalProcedure(ctx, "Text").MinDeposit.IsEqual(minDeposit))
+	require.True(t, GetProposalProcedure(ctx, "Terminator").MinDeposit.IsEqual(minDeposit))
+}
@@ -21,3 +21,27 @@ func GetTallyingProcedure(ctx sdk.Context) TallyingProcedure {
 	TallyingProcedureParameter.LoadValue(ctx)
 	return TallyingProcedureParameter.Value
 }
+
+// Returns the current Deposit Policy from the global param store
+func GetDepositPolicy(ctx sdk.Context) DepositPolicy {
+	DepositPolicyParameter.LoadValue(ctx)
+	return DepositPolicyParameter.Value
+}
+
+// Returns the current procedure of a kind of proposal from the global param store,
+// the global deposit, voting and tallying procedures apply if the kind has no procedure of its own
+func GetProposalProcedure(ctx sdk.Context, proposalType string) ProposalProcedure {
+	if ProposalProceduresParameter.LoadValueByKey(ctx, proposalType) {
+		procedure, _ := ProposalProceduresParameter.Value.Get(proposalType)
+		return procedure
+	}
+	tallyingProcedure := GetTallyingProcedure(ctx)
+	return ProposalProcedure{
+		ProposalType:  proposalType,
+		MinDeposit:    GetDepositProcedure(ctx).MinDeposit,
+		VotingPeriod:  GetVotingProcedure(ctx).VotingPeriod,
+		Threshold:     tallyingProcedure.Threshold,
+		Veto:          tallyingProcedure.Veto,
+		Participation: tallyingProcedure.Participation,
+	}
+}
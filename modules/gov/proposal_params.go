@@ -17,22 +17,98 @@ type Param struct {
 	Op    string `json:"op"`
 }
 
+// Params - the parameter changes of a proposal, applied all or nothing
+type Params []Param
+
+// Validate checks every change against its governable parameter
+func (ps Params) Validate() sdk.Error {
+	if len(ps) == 0 {
+		return ErrEmptyParams(DefaultCodespace)
+	}
+	for i, param := range ps {
+		for _, prev := range ps[:i] {
+			if prev.Key == param.Key && prev.Op == Update && param.Op == Update {
+				return ErrDuplicateParam(DefaultCodespace, param.Key)
+			}
+		}
+		p, ok := params.ParamMapping[param.Key]
+		if !ok {
+			return ErrInvalidParam(DefaultCodespace)
+		}
+		switch param.Op {
+		case Update:
+			if err := p.Valid(param.Value); err != nil {
+				return err
+			}
+		case Insert:
+			ap, ok := p.(params.GovArrayParameter)
+			if !ok {
+				return ErrNotArrayParam(DefaultCodespace, param.Key)
+			}
+			if err := ap.ValidInsert(param.Value); err != nil {
+				return err
+			}
+		default:
+			return ErrInvalidParamOp(DefaultCodespace, param.Op)
+		}
+	}
+	return nil
+}
+
 // Implements Proposal Interface
 var _ Proposal = (*ParameterProposal)(nil)
 
 type ParameterProposal struct {
 	TextProposal
-	Param Param `json:"params"`
+	Params Params `json:"params"`
 }
 
+// Execute applies all the changes in a cached context, nothing is written if any change fails
 func (pp *ParameterProposal) Execute(ctx sdk.Context, k Keeper) (err error) {
 
 	logger := ctx.Logger().With("module", "x/gov")
 	logger.Info("Execute ParameterProposal begin", "info", fmt.Sprintf("current height:%d", ctx.BlockHeight()))
-	if pp.Param.Op == Update {
-		params.ParamMapping[pp.Param.Key].Update(ctx, pp.Param.Value)
-	} else if pp.Param.Op == Insert {
-		//Todo: insert
+
+	cacheCtx, write := ctx.CacheContext()
+	if err = pp.Params.apply(cacheCtx); err != nil {
+		// the parameters keep their values in memory, reload them from the untouched store
+		pp.Params.reload(ctx)
+		logger.Error("Execute ParameterProposal failed, all the changes are rolled back", "err", err.Error())
+		return err
+	}
+	write()
+	return nil
+}
+
+func (ps Params) apply(ctx sdk.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to change parameters: %v", r)
+		}
+	}()
+
+	// the parameters may have been changed since the submission, validate them again
+	if err := ps.Validate(); err != nil {
+		return err
+	}
+	for _, param := range ps {
+		p := params.ParamMapping[param.Key]
+		if param.Op == Insert {
+			if err := p.(params.GovArrayParameter).Insert(ctx, param.Value); err != nil {
+				return err
+			}
+		} else {
+			// an update returns no error, a failing one panics
+			p.Update(ctx, param.Value)
+		}
+	}
+	return nil
+}
+
+func (ps Params) reload(ctx sdk.Context) {
+	for _, param := range ps {
+		if p, ok := params.ParamMapping[param.Key]; ok {
+			p.LoadValue(ctx)
+		}
 	}
-	return
 }
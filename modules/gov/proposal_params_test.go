@@ -0,0 +1,179 @@
+package gov
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/gov/params"
+	"github.com/irisnet/irishub/modules/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// failingParam - a governable parameter whose update always fails
+type failingParam struct{}
+
+func (param *failingParam) InitGenesis(interface{})                  {}
+func (param *failingParam) GetStoreKey() []byte                      { return []byte("failingParam") }
+func (param *failingParam) SetReadWriter(paramSpace params.Subspace) {}
+func (param *failingParam) SaveValue(ctx sdk.Context)                {}
+func (param *failingParam) LoadValue(ctx sdk.Context) bool           { return false }
+func (param *failingParam) Valid(json string) sdk.Error              { return nil }
+func (param *failingParam) ToJson(string) string                     { return "" }
+func (param *failingParam) Update(ctx sdk.Context, json string)      { panic("update failed") }
+func (param *failingParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	return nil
+}
+
+func TestParameterProposal_Rollback(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	params.RegisterGovParamMapping(&failingParam{})
+	defer delete(params.ParamMapping, "Gov/failingParam")
+
+	votingProcedure := govparams.GetVotingProcedure(ctx)
+	newVotingProcedure, _ := json.Marshal(govparams.VotingProcedure{VotingPeriod: 60 * time.Second})
+
+	// the second change fails, the first one is rolled back
+	pp := ParameterProposal{Params: Params{
+		{Key: "Gov/govVotingProcedure", Value: string(newVotingProcedure), Op: Update},
+		{Key: "Gov/failingParam", Value: "{}", Op: Update},
+	}}
+	require.Nil(t, pp.Params.Validate())
+	require.NotNil(t, pp.Execute(ctx, keeper))
+	require.Equal(t, votingProcedure, govparams.GetVotingProcedure(ctx))
+	require.Equal(t, votingProcedure, govparams.VotingProcedureParameter.Value)
+
+	pp = ParameterProposal{Params: Params{
+		{Key: "Gov/govVotingProcedure", Value: string(newVotingProcedure), Op: Update},
+	}}
+	require.Nil(t, pp.Execute(ctx, keeper))
+	require.Equal(t, 60*time.Second, govparams.GetVotingProcedure(ctx).VotingPeriod)
+}
+
+// listParam - a governable list of names, updated as a whole or inserted one by one
+type listParam struct {
+	Value []string
+	saved []string
+}
+
+func (param *listParam) InitGenesis(interface{})                  {}
+func (param *listParam) GetStoreKey() []byte                      { return []byte("listParam") }
+func (param *listParam) SetReadWriter(paramSpace params.Subspace) {}
+func (param *listParam) SaveValue(ctx sdk.Context)                { param.saved = param.Value }
+func (param *listParam) LoadValue(ctx sdk.Context) bool           { param.Value = param.saved; return true }
+func (param *listParam) ToJson(string) string                     { return "" }
+func (param *listParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	return nil
+}
+func (param *listParam) LoadValueByKey(ctx sdk.Context, key string) bool { return param.LoadValue(ctx) }
+
+func (param *listParam) Valid(jsonStr string) sdk.Error {
+	var value []string
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+func (param *listParam) ValidInsert(jsonStr string) sdk.Error {
+	var name string
+	if err := json.Unmarshal([]byte(jsonStr), &name); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+func (param *listParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err != nil {
+		panic(err)
+	}
+	param.SaveValue(ctx)
+}
+
+func (param *listParam) Insert(ctx sdk.Context, jsonStr string) sdk.Error {
+	var name string
+	if err := json.Unmarshal([]byte(jsonStr), &name); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	param.LoadValue(ctx)
+	param.Value = append(param.Value, name)
+	param.SaveValue(ctx)
+	return nil
+}
+
+func TestParameterProposal_Insert(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	list := &listParam{saved: []string{"a"}}
+	params.RegisterGovParamMapping(list)
+	defer delete(params.ParamMapping, "Gov/listParam")
+
+	// only an array parameter accepts an insert
+	pp := ParameterProposal{Params: Params{
+		{Key: "Gov/govVotingProcedure", Value: `{"voting_period":60000000000}`, Op: Insert},
+	}}
+	require.Equal(t, CodeNotArrayParam, pp.Params.Validate().Code())
+
+	// an update takes the whole list, an insert a single element
+	pp = ParameterProposal{Params: Params{{Key: "Gov/listParam", Value: `"b"`, Op: Update}}}
+	require.NotNil(t, pp.Params.Validate())
+	pp = ParameterProposal{Params: Params{{Key: "Gov/listParam", Value: `["b"]`, Op: Insert}}}
+	require.NotNil(t, pp.Params.Validate())
+
+	pp = ParameterProposal{Params: Params{
+		{Key: "Gov/listParam", Value: `"b"`, Op: Insert},
+		{Key: "Gov/listParam", Value: `"c"`, Op: Insert},
+	}}
+	require.Nil(t, pp.Params.Validate())
+	require.Nil(t, pp.Execute(ctx, keeper))
+	require.Equal(t, []string{"a", "b", "c"}, list.saved)
+
+	pp = ParameterProposal{Params: Params{{Key: "Gov/listParam", Value: `["d"]`, Op: Update}}}
+	require.Nil(t, pp.Execute(ctx, keeper))
+	require.Equal(t, []string{"d"}, list.saved)
+}
+
+func TestParameterProposal_ProposalProcedures(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// inserting the procedure of a kind of proposal replaces the existing one
+	procedure := govparams.GetProposalProcedure(ctx, "Text")
+	procedure.VotingPeriod = 60 * time.Second
+	procedure.Threshold = sdk.NewDecWithPrec(6, 1)
+	bz, _ := json.Marshal(procedure)
+	pp := ParameterProposal{Params: Params{
+		{Key: "Gov/govProposalProcedures", Value: string(bz), Op: Insert},
+	}}
+	require.Nil(t, pp.Params.Validate())
+	require.Nil(t, pp.Execute(ctx, keeper))
+	require.True(t, govparams.GetProposalProcedure(ctx, "Text").Threshold.Equal(sdk.NewDecWithPrec(6, 1)))
+	require.Equal(t, len(govparams.DefaultProposalProcedures()), len(govparams.ProposalProceduresParameter.Value))
+
+	// an update needs the whole list, an insert a single procedure
+	pp = ParameterProposal{Params: Params{
+		{Key: "Gov/govProposalProcedures", Value: string(bz), Op: Update},
+	}}
+	require.NotNil(t, pp.Params.Validate())
+	list, _ := json.Marshal(govparams.DefaultProposalProcedures())
+	pp = ParameterProposal{Params: Params{
+		{Key: "Gov/govProposalProcedures", Value: string(list), Op: Insert},
+	}}
+	require.NotNil(t, pp.Params.Validate())
+
+	pp = ParameterProposal{Params: Params{
+		{Key: "Gov/govProposalProcedures", Value: string(list), Op: Update},
+	}}
+	require.Nil(t, pp.Params.Validate())
+	require.Nil(t, pp.Execute(ctx, keeper))
+	require.True(t, govparams.GetProposalProcedure(ctx, "Text").Threshold.Equal(sdk.NewDecWithPrec(5, 1)))
+}
@@ -0,0 +1,248 @@
+package gov
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	distrtypes "github.com/irisnet/irishub/modules/distribution/types"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/pkg/errors"
+)
+
+var _ Proposal = (*CommunityTaxUsageProposal)(nil)
+
+// CommunityTaxUsageProposal spends a share of the community pool once it passes
+type CommunityTaxUsageProposal struct {
+	TextProposal
+	TaxUsage TaxUsage `json:"tax_usage"`
+}
+
+func (tp *CommunityTaxUsageProposal) Execute(ctx sdk.Context, k Keeper) error {
+	logger := ctx.Logger().With("module", "x/gov")
+	logger.Info("Execute CommunityTaxUsageProposal begin", "info", fmt.Sprintf("current height:%d", ctx.BlockHeight()))
+
+	cacheCtx, write := ctx.CacheContext()
+	record, err := k.spendCommunityPool(cacheCtx, tp.ProposalID, tp.TaxUsage)
+	if err != nil {
+		return err
+	}
+	write()
+	logger.Info("Execute CommunityTaxUsageProposal end", "usage", tp.TaxUsage.Usage.String(), "amount", record.Amount.String())
+	return nil
+}
+
+// TaxUsage - how the community pool is spent, Percent is the share of the pool to spend.
+// Burn drops the coins, Distribute splits them equally among the Recipients and Grant sends them to the trustee DestAddress.
+type TaxUsage struct {
+	Usage       UsageType        `json:"usage"`
+	Percent     sdk.Dec          `json:"percent"`
+	DestAddress sdk.AccAddress   `json:"dest_address"`
+	Recipients  []sdk.AccAddress `json:"recipients"`
+}
+
+func (tu TaxUsage) ValidateBasic() sdk.Error {
+	if !validUsageType(tu.Usage) {
+		return ErrInvalidUsageType(DefaultCodespace, tu.Usage)
+	}
+	if tu.Percent.IsNil() || !tu.Percent.GT(sdk.ZeroDec()) || tu.Percent.GT(sdk.OneDec()) {
+		return ErrInvalidTaxUsagePercent(DefaultCodespace, tu.Percent)
+	}
+	switch tu.Usage {
+	case UsageTypeBurn:
+		if len(tu.DestAddress) > 0 || len(tu.Recipients) > 0 {
+			return ErrInvalidTaxUsageRecipients(DefaultCodespace, "burn takes no recipient")
+		}
+	case UsageTypeDistribute:
+		if len(tu.DestAddress) > 0 || len(tu.Recipients) == 0 {
+			return ErrInvalidTaxUsageRecipients(DefaultCodespace, "distribute requires recipients and no destination address")
+		}
+		for i, recipient := range tu.Recipients {
+			if len(recipient) == 0 {
+				return sdk.ErrInvalidAddress("recipient address can't be empty")
+			}
+			for _, prev := range tu.Recipients[:i] {
+				if prev.Equals(recipient) {
+					return ErrInvalidTaxUsageRecipients(DefaultCodespace, fmt.Sprintf("duplicate recipient %s", recipient))
+				}
+			}
+		}
+	case UsageTypeGrant:
+		if len(tu.DestAddress) == 0 || len(tu.Recipients) > 0 {
+			return ErrInvalidTaxUsageRecipients(DefaultCodespace, "grant requires a destination address and no recipients")
+		}
+	}
+	return nil
+}
+
+// TaxUsageRecord - an executed spend of the community pool
+type TaxUsageRecord struct {
+	ProposalID uint64           `json:"proposal_id"`
+	Usage      UsageType        `json:"usage"`
+	Height     int64            `json:"height"`
+	Amount     sdk.Coins        `json:"amount"` // total amount taken from the community pool
+	Recipients []sdk.AccAddress `json:"recipients"`
+}
+
+//-----------------------------------------------------------
+// UsageType
+
+type UsageType byte
+
+const (
+	UsageTypeNil        UsageType = 0x00
+	UsageTypeBurn       UsageType = 0x01
+	UsageTypeDistribute UsageType = 0x02
+	UsageTypeGrant      UsageType = 0x03
+)
+
+// String to UsageType byte, Returns ff if invalid.
+func UsageTypeFromString(str string) (UsageType, error) {
+	switch str {
+	case "Burn":
+		return UsageTypeBurn, nil
+	case "Distribute":
+		return UsageTypeDistribute, nil
+	case "Grant":
+		return UsageTypeGrant, nil
+	case "":
+		return UsageTypeNil, nil
+	default:
+		return UsageType(0xff), errors.Errorf("'%s' is not a valid usage type", str)
+	}
+}
+
+func validUsageType(ut UsageType) bool {
+	return ut == UsageTypeBurn || ut == UsageTypeDistribute || ut == UsageTypeGrant
+}
+
+// Turns UsageType byte to String
+func (ut UsageType) String() string {
+	switch ut {
+	case UsageTypeBurn:
+		return "Burn"
+	case UsageTypeDistribute:
+		return "Distribute"
+	case UsageTypeGrant:
+		return "Grant"
+	default:
+		return ""
+	}
+}
+
+// For Printf / Sprintf, returns the string form when using %s
+// nolint: errcheck
+func (ut UsageType) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(ut.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(ut))))
+	}
+}
+
+// Marshals to JSON using string
+func (ut UsageType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ut.String())
+}
+
+// Unmarshals from JSON
+func (ut *UsageType) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := UsageTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*ut = bz2
+	return nil
+}
+
+//-----------------------------------------------------------
+// Keeper
+
+var PrefixTaxUsage = []byte("taxUsages")
+
+// Key for getting the spend of a proposal, ordered by proposal id
+func KeyTaxUsage(proposalID uint64) []byte {
+	return bytes.Join([][]byte{
+		PrefixTaxUsage,
+		sdk.Uint64ToBigEndian(proposalID),
+	}, KeyDelimiter)
+}
+
+// spendCommunityPool takes the share of the community pool and pays it out as the usage says.
+// The coins of the pool are not held by any account, so burning them takes them from the pool and from the supply.
+func (keeper Keeper) spendCommunityPool(ctx sdk.Context, proposalID uint64, usage TaxUsage) (record TaxUsageRecord, err sdk.Error) {
+	recipients := usage.Recipients
+	if usage.Usage == UsageTypeGrant {
+		// the trustee may have been removed since the submission
+		if _, found := keeper.gk.GetTrustee(ctx, usage.DestAddress); !found {
+			return record, ErrNotTrustee(keeper.codespace, usage.DestAddress)
+		}
+		recipients = []sdk.AccAddress{usage.DestAddress}
+	}
+
+	feePool := keeper.dk.GetFeePool(ctx)
+	spend, _ := feePool.CommunityPool.MulDec(usage.Percent).TruncateDecimal()
+
+	amount := spend
+	if len(recipients) > 0 {
+		// every recipient gets an equal share, the remainder stays in the pool
+		share := sdk.Coins{}
+		for _, coin := range spend {
+			if each := coin.Amount.DivRaw(int64(len(recipients))); each.Sign() > 0 {
+				share = append(share, sdk.NewCoin(coin.Denom, each))
+			}
+		}
+		amount = sdk.Coins{}
+		for _, recipient := range recipients {
+			if _, _, err := keeper.ck.AddCoins(ctx, recipient, share); err != nil {
+				return record, err
+			}
+			amount = amount.Plus(share)
+		}
+	} else {
+		// nobody receives the burned coins, they leave the supply
+		keeper.ck.DecreaseSupply(ctx, amount)
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Minus(distrtypes.NewDecCoins(amount))
+	keeper.dk.SetFeePool(ctx, feePool)
+
+	record = TaxUsageRecord{
+		ProposalID: proposalID,
+		Usage:      usage.Usage,
+		Height:     ctx.BlockHeight(),
+		Amount:     amount,
+		Recipients: recipients,
+	}
+	keeper.SetTaxUsageRecord(ctx, record)
+	return record, nil
+}
+
+func (keeper Keeper) GetTaxUsageRecord(ctx sdk.Context, proposalID uint64) (record TaxUsageRecord, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyTaxUsage(proposalID))
+	if bz == nil {
+		return record, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+func (keeper Keeper) SetTaxUsageRecord(ctx sdk.Context, record TaxUsageRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(KeyTaxUsage(record.ProposalID), bz)
+}
+
+// Gets all the spends of the community pool, from the latest proposal
+func (keeper Keeper) GetTaxUsageRecords(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return sdk.KVStoreReversePrefixIterator(store, bytes.Join([][]byte{PrefixTaxUsage, {}}, KeyDelimiter))
+}
@@ -0,0 +1,111 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/modules/distribution"
+	distrtypes "github.com/irisnet/irishub/modules/distribution/types"
+	"github.com/irisnet/irishub/modules/guardian"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func setCommunityPool(ctx sdk.Context, keeper Keeper, coins sdk.Coins) {
+	feePool := distribution.InitialFeePool()
+	feePool.CommunityPool = distrtypes.NewDecCoins(coins)
+	keeper.dk.SetFeePool(ctx, feePool)
+}
+
+func TestKeeper_SpendCommunityPool(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 4)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	setCommunityPool(ctx, keeper, sdk.Coins{sdk.NewInt64Coin("iris-atto", 1000)})
+
+	// burn takes the coins from the pool and from the supply
+	supply := keeper.ck.GetTotalSupply(ctx).AmountOf("iris-atto")
+	record, err := keeper.spendCommunityPool(ctx, 1, TaxUsage{Usage: UsageTypeBurn, Percent: sdk.NewDecWithPrec(1, 1)})
+	require.Nil(t, err)
+	require.True(t, record.Amount.IsEqual(sdk.Coins{sdk.NewInt64Coin("iris-atto", 100)}))
+	require.Equal(t, 0, len(record.Recipients))
+	require.True(t, keeper.ck.GetTotalSupply(ctx).AmountOf("iris-atto").Equal(supply.SubRaw(100)))
+	require.True(t, keeper.dk.GetFeePool(ctx).CommunityPool.AmountOf("iris-atto").Equal(sdk.NewDec(900)))
+
+	// distribute splits the coins equally, the remainder stays in the pool
+	coins := keeper.ck.GetCoins(ctx, addrs[0])
+	recipients := []sdk.AccAddress{addrs[0], addrs[1], addrs[2]}
+	record, err = keeper.spendCommunityPool(ctx, 2, TaxUsage{Usage: UsageTypeDistribute, Percent: sdk.NewDecWithPrec(5, 1), Recipients: recipients})
+	require.Nil(t, err)
+	require.True(t, record.Amount.IsEqual(sdk.Coins{sdk.NewInt64Coin("iris-atto", 450)}))
+	require.Equal(t, recipients, record.Recipients)
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[0]).IsEqual(coins.Plus(sdk.Coins{sdk.NewInt64Coin("iris-atto", 150)})))
+	require.True(t, keeper.ck.GetTotalSupply(ctx).AmountOf("iris-atto").Equal(supply.SubRaw(100)))
+	require.True(t, keeper.dk.GetFeePool(ctx).CommunityPool.AmountOf("iris-atto").Equal(sdk.NewDec(450)))
+
+	recipients = []sdk.AccAddress{addrs[0], addrs[1], addrs[2], addrs[3]}
+	record, err = keeper.spendCommunityPool(ctx, 3, TaxUsage{Usage: UsageTypeDistribute, Percent: sdk.NewDecWithPrec(5, 1), Recipients: recipients})
+	require.Nil(t, err)
+	require.True(t, record.Amount.IsEqual(sdk.Coins{sdk.NewInt64Coin("iris-atto", 224)}))
+	require.True(t, keeper.dk.GetFeePool(ctx).CommunityPool.AmountOf("iris-atto").Equal(sdk.NewDec(226)))
+
+	// grant pays a trustee only
+	usage := TaxUsage{Usage: UsageTypeGrant, Percent: sdk.OneDec(), DestAddress: addrs[3]}
+	_, err = keeper.spendCommunityPool(ctx, 4, usage)
+	require.NotNil(t, err)
+	require.Equal(t, CodeNotTrustee, err.Code())
+
+	keeper.gk.AddTrustee(ctx, guardian.NewTrustee(addrs[3]))
+	coins = keeper.ck.GetCoins(ctx, addrs[3])
+	record, err = keeper.spendCommunityPool(ctx, 4, usage)
+	require.Nil(t, err)
+	require.True(t, record.Amount.IsEqual(sdk.Coins{sdk.NewInt64Coin("iris-atto", 226)}))
+	require.Equal(t, []sdk.AccAddress{addrs[3]}, record.Recipients)
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[3]).IsEqual(coins.Plus(sdk.Coins{sdk.NewInt64Coin("iris-atto", 226)})))
+	require.True(t, keeper.dk.GetFeePool(ctx).CommunityPool.AmountOf("iris-atto").IsZero())
+
+	_, found := keeper.GetTaxUsageRecord(ctx, 4)
+	require.True(t, found)
+}
+
+func TestQuerier_TaxUsages(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	setCommunityPool(ctx, keeper, sdk.Coins{sdk.NewInt64Coin("iris-atto", 1000)})
+
+	_, err := keeper.spendCommunityPool(ctx, 1, TaxUsage{Usage: UsageTypeBurn, Percent: sdk.NewDecWithPrec(1, 1)})
+	require.Nil(t, err)
+	_, err = keeper.spendCommunityPool(ctx, 2, TaxUsage{Usage: UsageTypeDistribute, Percent: sdk.NewDecWithPrec(1, 1), Recipients: addrs})
+	require.Nil(t, err)
+	_, err = keeper.spendCommunityPool(ctx, 3, TaxUsage{Usage: UsageTypeBurn, Percent: sdk.NewDecWithPrec(1, 1)})
+	require.Nil(t, err)
+
+	querier := NewQuerier(keeper)
+	query := func(params QueryTaxUsagesParams) []TaxUsageRecord {
+		bz, _ := keeper.cdc.MarshalJSON(params)
+		res, err := querier(ctx, []string{QueryTaxUsages}, abci.RequestQuery{Data: bz})
+		require.Nil(t, err)
+		var records []TaxUsageRecord
+		keeper.cdc.MustUnmarshalJSON(res, &records)
+		return records
+	}
+
+	// from the latest spend
+	records := query(QueryTaxUsagesParams{})
+	require.Equal(t, 3, len(records))
+	require.Equal(t, uint64(3), records[0].ProposalID)
+	require.Equal(t, uint64(1), records[2].ProposalID)
+
+	records = query(QueryTaxUsagesParams{Usage: UsageTypeBurn})
+	require.Equal(t, 2, len(records))
+	require.Equal(t, UsageTypeBurn, records[1].Usage)
+
+	records = query(QueryTaxUsagesParams{Limit: 1})
+	require.Equal(t, 1, len(records))
+	require.Equal(t, uint64(3), records[0].ProposalID)
+
+	records = query(QueryTaxUsagesParams{Usage: UsageTypeDistribute})
+	require.Equal(t, 1, len(records))
+	require.True(t, records[0].Amount.IsEqual(sdk.Coins{sdk.NewInt64Coin("iris-atto", 90)}))
+}
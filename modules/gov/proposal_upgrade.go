@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/upgrade/params"
@@ -10,15 +12,21 @@ var _ Proposal = (*SoftwareUpgradeProposal)(nil)
 
 type SoftwareUpgradeProposal struct {
 	TextProposal
-
+	////////////////////  iris begin  ///////////////////////////
+	UpgradeConfig upgradeparams.UpgradeConfig `json:"upgrade_config"` // the software switched to, empty for legacy proposals
+	////////////////////  iris end  /////////////////////////////
 }
 
 func (sp *SoftwareUpgradeProposal) Execute(ctx sdk.Context, k Keeper) error {
 	logger := ctx.Logger().With("module", "x/gov")
 
 	if upgradeparams.GetCurrentUpgradeProposalId(ctx) == 0 {
+		if sp.UpgradeConfig.SwitchHeight > 0 && sp.UpgradeConfig.SwitchHeight <= ctx.BlockHeight() {
+			return fmt.Errorf("switch height %d is not after the current height %d", sp.UpgradeConfig.SwitchHeight, ctx.BlockHeight())
+		}
 		upgradeparams.SetCurrentUpgradeProposalId(ctx,sp.ProposalID)
 		upgradeparams.SetProposalAcceptHeight(ctx,ctx.BlockHeight())
+		upgradeparams.SetUpgradeConfig(ctx, sp.UpgradeConfig)
 		logger.Info("Execute SoftwareProposal begin", "info", fmt.Sprintf("current height:%d", ctx.BlockHeight()))
 
 	} else {
@@ -28,3 +36,22 @@ func (sp *SoftwareUpgradeProposal) Execute(ctx sdk.Context, k Keeper) error {
 
 	return nil
 }
+
+////////////////////  iris begin  ///////////////////////////
+// validateUpgradeConfig checks the software metadata declared by an upgrade proposal
+func validateUpgradeConfig(config upgradeparams.UpgradeConfig) sdk.Error {
+	if config.ProtocolVersion < 0 {
+		return ErrInvalidUpgradeConfig(DefaultCodespace, fmt.Sprintf("protocol version %d can not be negative", config.ProtocolVersion))
+	}
+	if config.SwitchHeight < 0 {
+		return ErrInvalidUpgradeConfig(DefaultCodespace, fmt.Sprintf("switch height %d can not be negative", config.SwitchHeight))
+	}
+	if len(config.Checksum) != 0 {
+		checksum, err := hex.DecodeString(config.Checksum)
+		if err != nil || len(checksum) != sha256.Size {
+			return ErrInvalidUpgradeConfig(DefaultCodespace, fmt.Sprintf("checksum %s is not a hex encoded sha256 hash", config.Checksum))
+		}
+	}
+	return nil
+}
+////////////////////  iris end  /////////////////////////////
@@ -0,0 +1,29 @@
+package gov
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/modules/upgrade/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var _ Proposal = (*SoftwareUpgradeAbortProposal)(nil)
+
+// SoftwareUpgradeAbortProposal cancels the software upgrade in its switch period,
+// the upgrade module drops the switch at the end of the block the proposal passes in
+type SoftwareUpgradeAbortProposal struct {
+	TextProposal
+}
+
+func (sp *SoftwareUpgradeAbortProposal) Execute(ctx sdk.Context, k Keeper) error {
+	logger := ctx.Logger().With("module", "x/gov")
+
+	upgradeProposalID := upgradeparams.GetCurrentUpgradeProposalId(ctx)
+	if upgradeProposalID == 0 {
+		return fmt.Errorf("there is no software upgrade in its switch period to abort")
+	}
+	upgradeparams.SetAbortUpgradeProposalId(ctx, upgradeProposalID)
+	logger.Info("Execute SoftwareUpgradeAbortProposal", "info", fmt.Sprintf("abort upgrade proposal:%d", upgradeProposalID))
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package gov
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/irisnet/irishub/modules/params"
+	"github.com/irisnet/irishub/modules/upgrade/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestValidateUpgradeConfig(t *testing.T) {
+	hash := sha256.Sum256([]byte("iris"))
+	checksum := hex.EncodeToString(hash[:])
+
+	require.Nil(t, validateUpgradeConfig(upgradeparams.UpgradeConfig{}))
+	require.Nil(t, validateUpgradeConfig(upgradeparams.UpgradeConfig{ProtocolVersion: 1, SwitchHeight: 100, Checksum: checksum}))
+	require.Nil(t, validateUpgradeConfig(upgradeparams.UpgradeConfig{Checksum: strings.ToUpper(checksum)}))
+
+	invalidConfigs := []upgradeparams.UpgradeConfig{
+		{ProtocolVersion: -1},
+		{SwitchHeight: -1},
+		{Checksum: "iris"},
+		{Checksum: checksum[:len(checksum)-2]},
+		{Checksum: checksum + "00"},
+	}
+	for _, config := range invalidConfigs {
+		err := validateUpgradeConfig(config)
+		require.NotNil(t, err, "%v", config)
+		require.Equal(t, CodeInvalidUpgradeConfig, err.Code())
+	}
+}
+
+func TestMsgSubmitSoftwareUpgradeProposal(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	params.SetParamReadWriter(mapp.ParamsKeeper.Subspace(params.SignalParamspace).WithTypeTable(
+		params.NewTypeTable(
+			upgradeparams.CurrentUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
+			upgradeparams.UpgradeConfigParameter.GetStoreKey(), upgradeparams.UpgradeConfig{},
+		)),
+		&upgradeparams.CurrentUpgradeProposalIdParameter,
+		&upgradeparams.UpgradeConfigParameter)
+
+	newMsg := func(config upgradeparams.UpgradeConfig) MsgSubmitSoftwareUpgradeProposal {
+		return NewMsgSubmitSoftwareUpgradeProposal(NewMsgSubmitProposal("Upgrade", "description",
+			ProposalTypeSoftwareUpgrade, addrs[0], irisCoins(5), nil), config)
+	}
+
+	// the config is checked by ValidateBasic
+	require.Equal(t, CodeInvalidUpgradeConfig, newMsg(upgradeparams.UpgradeConfig{Checksum: "iris"}).ValidateBasic().Code())
+
+	// the switch height has to be after the current height
+	res := handleMsgSubmitSoftwareUpgradeProposal(ctx, keeper, newMsg(upgradeparams.UpgradeConfig{SwitchHeight: 10}))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidUpgradeConfig), res.Code)
+
+	config := upgradeparams.UpgradeConfig{ProtocolVersion: 1, Software: "https://github.com/irisnet/irishub", SwitchHeight: 11}
+	msg := newMsg(config)
+	require.Nil(t, msg.ValidateBasic())
+	res = handleMsgSubmitSoftwareUpgradeProposal(ctx, keeper, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	proposal := keeper.GetProposal(ctx, keeper.GetLastProposalID(ctx))
+	upgradeProposal, ok := proposal.(*SoftwareUpgradeProposal)
+	require.True(t, ok)
+	require.Equal(t, config, upgradeProposal.UpgradeConfig)
+}
@@ -46,6 +46,9 @@ type Proposal interface {
 	GetVotingEndTime() time.Time
 	SetVotingEndTime(time.Time)
 	////////////////////  iris begin  ///////////////////////////
+	GetProposer() sdk.AccAddress
+	SetProposer(sdk.AccAddress)
+
 	Execute(ctx sdk.Context, k Keeper) error
 ////////////////////  iris end  ///////////////////////////
 }
@@ -85,6 +88,9 @@ type TextProposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time"` //  Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
+	////////////////////  iris begin  ///////////////////////////
+	Proposer sdk.AccAddress `json:"proposer"` //  Address of the proposer, who may cancel the proposal during the deposit period
+	////////////////////  iris end  /////////////////////////////
 }
 
 // Implements Proposal Interface
@@ -121,6 +127,9 @@ func (tp *TextProposal) SetVotingEndTime(votingEndTime time.Time) {
 }
 
 ////////////////////  iris begin  ///////////////////////////
+func (tp TextProposal) GetProposer() sdk.AccAddress          { return tp.Proposer }
+func (tp *TextProposal) SetProposer(proposer sdk.AccAddress) { tp.Proposer = proposer }
+
 func (pp *TextProposal) Execute(ctx sdk.Context, k Keeper) (err error) {return nil}
 ////////////////////  iris end  /////////////////////////////
 
@@ -142,6 +151,8 @@ const (
 	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
 	////////////////////  iris begin  /////////////////////////////
 	ProposalTypeTerminator      ProposalKind = 0x04
+	ProposalTypeCommunityTaxUsage ProposalKind = 0x05
+	ProposalTypeSoftwareUpgradeAbort ProposalKind = 0x06
 	////////////////////  iris end  /////////////////////////////
 )
 
@@ -157,6 +168,10 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		////////////////////  iris begin  /////////////////////////////
 	case "Terminator":
 		return ProposalTypeTerminator, nil
+	case "CommunityTaxUsage":
+		return ProposalTypeCommunityTaxUsage, nil
+	case "SoftwareUpgradeAbort":
+		return ProposalTypeSoftwareUpgradeAbort, nil
 		////////////////////  iris end  /////////////////////////////
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
@@ -169,7 +184,9 @@ func validProposalType(pt ProposalKind) bool {
 		pt == ProposalTypeParameterChange ||
 		pt == ProposalTypeSoftwareUpgrade ||
 	////////////////////  iris begin  /////////////////////////////
-		pt == ProposalTypeTerminator  {
+		pt == ProposalTypeTerminator ||
+		pt == ProposalTypeCommunityTaxUsage ||
+		pt == ProposalTypeSoftwareUpgradeAbort {
 	////////////////////  iris end  /////////////////////////////
 		return true
 	}
@@ -220,6 +237,10 @@ func (pt ProposalKind) String() string {
 		////////////////////  iris begin  /////////////////////////////
 	case ProposalTypeTerminator:
 		return "Terminator"
+	case ProposalTypeCommunityTaxUsage:
+		return "CommunityTaxUsage"
+	case ProposalTypeSoftwareUpgradeAbort:
+		return "SoftwareUpgradeAbort"
 		////////////////////  iris end  /////////////////////////////
 	default:
 		return ""
@@ -2,6 +2,8 @@ package gov
 
 import (
 	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/gov/params"
+	"github.com/irisnet/irishub/modules/upgrade/params"
 	sdk "github.com/irisnet/irishub/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"time"
@@ -9,13 +11,15 @@ import (
 
 // query endpoints supported by the governance Querier
 const (
-	QueryProposals = "proposals"
-	QueryProposal  = "proposal"
-	QueryDeposits  = "deposits"
-	QueryDeposit   = "deposit"
-	QueryVotes     = "votes"
-	QueryVote      = "vote"
-	QueryTally     = "tally"
+	QueryProposals   = "proposals"
+	QueryProposal    = "proposal"
+	QueryDeposits    = "deposits"
+	QueryDeposit     = "deposit"
+	QueryVotes       = "votes"
+	QueryVote        = "vote"
+	QueryTally       = "tally"
+	QueryTaxUsages   = "tax_usages"
+	QueryVoteChanges = "vote_changes"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -35,6 +39,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QueryTaxUsages:
+			return queryTaxUsages(ctx, path[1:], req, keeper)
+		case QueryVoteChanges:
+			return queryVoteChanges(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -56,7 +64,10 @@ type ProposalOutput struct {
 
 	VotingStartTime time.Time `json:"voting_start_time"` //  Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
-	Param        Param `json:"param"`
+	Params          Params    `json:"params"`
+	TaxUsage        *TaxUsage `json:"tax_usage,omitempty"`
+	UpgradeConfig   *upgradeparams.UpgradeConfig `json:"upgrade_config,omitempty"`
+	Proposer        sdk.AccAddress `json:"proposer"`
 }
 
 type ProposalOutputs []ProposalOutput
@@ -78,11 +89,18 @@ func ConvertProposalToProposalOutput(proposal Proposal) ProposalOutput {
 
 		VotingStartTime: proposal.GetVotingStartTime(),
 		VotingEndTime:   proposal.GetVotingEndTime(),
-		Param:           Param{},
+		Params:          Params{},
+		Proposer:        proposal.GetProposer(),
 	}
 
 	if proposal.GetProposalType() == ProposalTypeParameterChange {
-		proposalOutput.Param = proposal.(*ParameterProposal).Param
+		proposalOutput.Params = proposal.(*ParameterProposal).Params
+	}
+	if proposal.GetProposalType() == ProposalTypeCommunityTaxUsage {
+		proposalOutput.TaxUsage = &proposal.(*CommunityTaxUsageProposal).TaxUsage
+	}
+	if proposal.GetProposalType() == ProposalTypeSoftwareUpgrade {
+		proposalOutput.UpgradeConfig = &proposal.(*SoftwareUpgradeProposal).UpgradeConfig
 	}
 	return proposalOutput
 }
@@ -224,6 +242,40 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// Params for query 'custom/gov/vote_changes', the changes of all the voters if no voter is given
+type QueryVoteChangesParams struct {
+	ProposalID uint64
+	Voter      sdk.AccAddress
+}
+
+// nolint: unparam
+func queryVoteChanges(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryVoteChangesParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	var changes []VoteChange
+	if len(params.Voter) > 0 {
+		changes = keeper.GetVoteChanges(ctx, params.ProposalID, params.Voter)
+	} else {
+		changesIterator := keeper.GetVoteChangesIterator(ctx, params.ProposalID)
+		for ; changesIterator.Valid(); changesIterator.Next() {
+			var voterChanges []VoteChange
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(changesIterator.Value(), &voterChanges)
+			changes = append(changes, voterChanges...)
+		}
+		changesIterator.Close()
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, changes)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
 // Params for query 'custom/gov/proposals'
 type QueryProposalsParams struct {
 	Voter              sdk.AccAddress
@@ -256,6 +308,12 @@ type QueryTallyParams struct {
 	ProposalID uint64
 }
 
+// TallyOutput - the tally of a proposal and the current procedure of its kind
+type TallyOutput struct {
+	TallyResult TallyResult                 `json:"tally_result"`
+	Procedure   govparams.ProposalProcedure `json:"procedure"`
+}
+
 // nolint: unparam
 func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
 	// TODO: Dependant on #1914
@@ -278,10 +336,52 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	} else if proposal.GetStatus() == StatusPassed || proposal.GetStatus() == StatusRejected {
 		tallyResult = proposal.GetTallyResult()
 	} else {
-		_, tallyResult = tally(ctx, keeper, proposal)
+		_, _, tallyResult = tally(ctx, keeper, proposal)
+	}
+
+	tallyOutput := TallyOutput{
+		TallyResult: tallyResult,
+		Procedure:   govparams.GetProposalProcedure(ctx, proposal.GetProposalType().String()),
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, tallyOutput)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/tax_usages', the spends of the community pool from the latest one,
+// filtered by the usage if given
+type QueryTaxUsagesParams struct {
+	Usage UsageType
+	Limit uint64
+}
+
+// nolint: unparam
+func queryTaxUsages(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryTaxUsagesParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	records := []TaxUsageRecord{}
+	iterator := keeper.GetTaxUsageRecords(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if params.Limit > 0 && uint64(len(records)) >= params.Limit {
+			break
+		}
+		var record TaxUsageRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if params.Usage != UsageTypeNil && record.Usage != params.Usage {
+			continue
+		}
+		records = append(records, record)
 	}
 
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, tallyResult)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, records)
 	if err2 != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
 	}
@@ -12,6 +12,10 @@ var (
 	ActionProposalDropped  = []byte("proposal-dropped")
 	ActionProposalPassed   = []byte("proposal-passed")
 	ActionProposalRejected = []byte("proposal-rejected")
+	////////////////////  iris begin  ///////////////////////////
+	ActionProposalExecuteFailed = []byte("proposal-execute-failed")
+	ActionProposalCancelled     = []byte("proposal-cancelled")
+	////////////////////  iris end  /////////////////////////////
 
 	Action            = sdk.TagAction
 	Proposer          = "proposer"
@@ -21,5 +25,7 @@ var (
 	Voter             = "voter"
 	////////////////////  iris begin  ///////////////////////////
 	Param             = "param"
+	Usage             = "usage"
+	DepositAction     = "deposit-action"
 	////////////////////  iris end  /////////////////////////////
 )
@@ -7,14 +7,14 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address         sdk.ValAddress // address of the validator operator
-	Power           sdk.Dec        // Power of a Validator
-	DelegatorShares sdk.Dec        // Total outstanding delegator shares
-	Minus           sdk.Dec        // Minus of validator, used to compute validator's voting power
-	Vote            VoteOption     // Vote of the validator
+	Address         sdk.ValAddress      // address of the validator operator
+	Power           sdk.Dec             // Power of a Validator
+	DelegatorShares sdk.Dec             // Total outstanding delegator shares
+	Minus           sdk.Dec             // Minus of validator, used to compute validator's voting power
+	Vote            WeightedVoteOptions // Vote of the validator
 }
 
-func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tallyResults TallyResult) {
+func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, vetoed bool, tallyResults TallyResult) {
 	results := make(map[VoteOption]sdk.Dec)
 	results[OptionYes] = sdk.ZeroDec()
 	results[OptionAbstain] = sdk.ZeroDec()
@@ -31,13 +31,13 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 			Power:           validator.GetPower(),
 			DelegatorShares: validator.GetDelegatorShares(),
 			Minus:           sdk.ZeroDec(),
-			Vote:            OptionEmpty,
+			Vote:            nil,
 		}
 		systemVotingPower = systemVotingPower.Add(validator.GetPower())
 		return false
 	})
 
-	// iterate over all the votes
+	// iterate over all the votes, they are kept once the proposal is tallied
 	votesIterator := keeper.GetVotes(ctx, proposal.GetProposalID())
 	defer votesIterator.Close()
 	for ; votesIterator.Valid(); votesIterator.Next() {
@@ -48,7 +48,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.WeightedOptions()
 			currValidators[valAddrStr] = val
 		} else {
 
@@ -62,20 +62,20 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 					votingPower := val.Power.Mul(delegatorShare)
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
+					for _, option := range vote.WeightedOptions() {
+						results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+					}
 					totalVotingPower = totalVotingPower.Add(votingPower)
 				}
 
 				return false
 			})
 		}
-
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 	}
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -83,12 +83,14 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 		percentAfterMinus := sharesAfterMinus.Quo(val.DelegatorShares)
 		votingPower := val.Power.Mul(percentAfterMinus)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
 	////////////////////  iris begin  ///////////////////////////
-	tallyingProcedure := govparams.GetTallyingProcedure(ctx)
+	tallyingProcedure := govparams.GetProposalProcedure(ctx, proposal.GetProposalType().String())
 	////////////////////  iris end  /////////////////////////////
 
 	tallyResults = TallyResult{
@@ -100,25 +102,25 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 
 	// If no one votes, proposal fails
 	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, tallyResults
+		return false, false, tallyResults
 	}
 	////////////////////  iris begin  ///////////////////////////
 	//if more than 1/3 of voters abstain, proposal fails
 	if tallyingProcedure.Participation.GT(totalVotingPower.Quo(systemVotingPower)) {
-		return false, tallyResults
+		return false, false, tallyResults
 	}
 	////////////////////  iris end  ///////////////////////////
 
 	// If more than 1/3 of voters veto, proposal fails
 	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyingProcedure.Veto) {
-		return false, tallyResults
+		return false, true, tallyResults
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyingProcedure.Threshold) {
-		return true, tallyResults
+		return true, false, tallyResults
 	}
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 
-	return false, tallyResults
+	return false, false, tallyResults
 }
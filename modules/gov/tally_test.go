@@ -0,0 +1,137 @@
+package gov
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/modules/stake"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// creates a bonded validator for every address, with the voting power given in iris
+func createValidators(t *testing.T, ctx sdk.Context, sk stake.Keeper, addrs []sdk.AccAddress, pubKeys []crypto.PubKey, powers []int64) {
+	stakeHandler := stake.NewHandler(sk)
+	for i, power := range powers {
+		msg := stake.NewMsgCreateValidator(sdk.ValAddress(addrs[i]), pubKeys[i],
+			sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(power, 18)), stake.Description{},
+			stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+		res := stakeHandler(ctx, msg)
+		require.True(t, res.IsOK(), res.Log)
+	}
+	stake.EndBlocker(ctx, sk)
+}
+
+func newVotingProposal(ctx sdk.Context, keeper Keeper) Proposal {
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	keeper.activateVotingPeriod(ctx, proposal)
+	return keeper.GetProposal(ctx, proposal.GetProposalID())
+}
+
+func TestTally_WeightedVotes(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 3)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	createValidators(t, ctx, sk, addrs[:2], pubKeys[:2], []int64{10, 10})
+
+	proposal := newVotingProposal(ctx, keeper)
+	proposalID := proposal.GetProposalID()
+
+	// a split vote with weights adding up to 1
+	err := keeper.AddWeightedVote(ctx, proposalID, addrs[0], WeightedVoteOptions{
+		{Option: OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	})
+	require.Nil(t, err)
+	err = keeper.AddVote(ctx, proposalID, addrs[1], OptionYes)
+	require.Nil(t, err)
+
+	passes, vetoed, tallyResults := tally(ctx, keeper, proposal)
+	require.True(t, passes)
+	require.False(t, vetoed)
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDec(16)))
+	require.True(t, tallyResults.No.Equal(sdk.NewDec(4)))
+
+	// a split vote with weights not adding up to 1 is rejected, the previous vote stays
+	err = keeper.AddWeightedVote(ctx, proposalID, addrs[0], WeightedVoteOptions{
+		{Option: OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Option: OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	})
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidWeightedVote, err.Code())
+	vote, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.True(t, vote.Options[0].Weight.Equal(sdk.NewDecWithPrec(6, 1)))
+
+	// a delegator splitting its vote takes its voting power from the validator's vote
+	res := stake.NewHandler(sk)(ctx, stake.NewMsgDelegate(addrs[2], sdk.ValAddress(addrs[1]),
+		sdk.NewCoin("iris-atto", sdk.NewIntWithDecimal(10, 18))))
+	require.True(t, res.IsOK(), res.Log)
+	stake.EndBlocker(ctx, sk)
+	err = keeper.AddWeightedVote(ctx, proposalID, addrs[2], WeightedVoteOptions{
+		{Option: OptionNo, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Option: OptionAbstain, Weight: sdk.NewDecWithPrec(5, 1)},
+	})
+	require.Nil(t, err)
+
+	passes, _, tallyResults = tally(ctx, keeper, proposal)
+	require.True(t, passes)
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDec(16)))
+	require.True(t, tallyResults.No.Equal(sdk.NewDec(9)))
+	require.True(t, tallyResults.Abstain.Equal(sdk.NewDec(5)))
+}
+
+func TestTally_VoteChanges(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{10, 10})
+
+	proposal := newVotingProposal(ctx, keeper)
+	proposalID := proposal.GetProposalID()
+
+	split := WeightedVoteOptions{
+		{Option: OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[0], split))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+	require.Equal(t, 0, len(keeper.GetVoteChanges(ctx, proposalID, addrs[0])))
+
+	// the vote is replaced and the change is kept
+	ctx = ctx.WithBlockHeight(5)
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionNo))
+	changes := keeper.GetVoteChanges(ctx, proposalID, addrs[0])
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, int64(5), changes[0].Height)
+	require.True(t, changes[0].From.Equals(split))
+	require.True(t, changes[0].To.Equals(NewNonSplitVoteOption(OptionNo)))
+
+	vote, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, OptionNo, vote.Option)
+
+	// only the latest vote is tallied, half of the voting power is not enough to pass
+	passes, _, tallyResults := tally(ctx, keeper, proposal)
+	require.False(t, passes)
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDec(10)))
+	require.True(t, tallyResults.No.Equal(sdk.NewDec(10)))
+
+	// the votes and their changes are kept once the proposal is tallied
+	ctx = ctx.WithBlockTime(proposal.GetVotingEndTime().Add(time.Second))
+	EndBlocker(ctx, keeper)
+	proposal = keeper.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusRejected, proposal.GetStatus())
+	require.True(t, proposal.GetTallyResult().No.Equal(sdk.NewDec(10)))
+
+	votes := 0
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+	for ; votesIterator.Valid(); votesIterator.Next() {
+		votes++
+	}
+	votesIterator.Close()
+	require.Equal(t, 2, votes)
+	require.Equal(t, 1, len(keeper.GetVoteChanges(ctx, proposalID, addrs[0])))
+}
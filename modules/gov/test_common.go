@@ -13,6 +13,8 @@ import (
 	"fmt"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/simulation/mock"
 	"github.com/irisnet/irishub/modules/stake"
 	"github.com/irisnet/irishub/modules/gov/params"
@@ -27,21 +29,26 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	RegisterCodec(mapp.Cdc)
 
 	keyGov := sdk.NewKVStoreKey("gov")
+	keyDistr := sdk.NewKVStoreKey("distr")
+	keyGuardian := sdk.NewKVStoreKey("guardian")
 
-	ck := bank.NewBaseKeeper(mapp.AccountKeeper)
+	ck := bank.NewBaseKeeper(mapp.Cdc, mapp.KeyBank, mapp.AccountKeeper)
 	sk := stake.NewKeeper(
 		mapp.Cdc,
 		mapp.KeyStake, mapp.TkeyStake,
 		mapp.BankKeeper, mapp.ParamsKeeper.Subspace(stake.DefaultParamspace),
 		mapp.RegisterCodespace(stake.DefaultCodespace))
-	gk := NewKeeper(mapp.Cdc, keyGov, ck, sk, DefaultCodespace)
+	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, mapp.ParamsKeeper.Subspace(distribution.DefaultParamspace),
+		ck, sk, mapp.FeeCollectionKeeper, distribution.DefaultCodespace)
+	guardianKeeper := guardian.NewKeeper(mapp.Cdc, keyGuardian, guardian.DefaultCodespace)
+	gk := NewKeeper(mapp.Cdc, keyGov, ck, dk, guardianKeeper, sk, DefaultCodespace)
 
-	mapp.Router().AddRoute("gov", []*sdk.KVStoreKey{keyGov}, NewHandler(gk))
+	mapp.Router().AddRoute("gov", []*sdk.KVStoreKey{keyGov, keyDistr, keyGuardian}, NewHandler(gk))
 
 	mapp.SetEndBlocker(getEndBlocker(gk))
 	mapp.SetInitChainer(getInitChainer(mapp, gk, sk))
 
-	require.NoError(t, mapp.CompleteSetup(keyGov))
+	require.NoError(t, mapp.CompleteSetup(keyGov, keyDistr, keyGuardian))
 
 	coin, _ := types.NewDefaultCoinType("iris").ConvertToMinCoin(fmt.Sprintf("%d%s", 1042, "iris"))
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
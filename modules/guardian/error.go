@@ -11,6 +11,10 @@ const (
 	CodeProfilerExists      sdk.CodeType = 100
 	CodeProfilerNotExists   sdk.CodeType = 101
 	CodeInvalidProfilerName sdk.CodeType = 102
+	CodeTrusteeExists       sdk.CodeType = 103
+	CodeTrusteeNotExists    sdk.CodeType = 104
+	CodeDeleteLastTrustee   sdk.CodeType = 105
+	CodeDuplicateApproval   sdk.CodeType = 106
 )
 
 func ErrProfilerNotExists(codespace sdk.CodespaceType, profiler sdk.AccAddress) sdk.Error {
@@ -24,3 +28,19 @@ func ErrProfilerExists(codespace sdk.CodespaceType, profiler sdk.AccAddress) sdk
 func ErrInvalidProfilerName(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidProfilerName, fmt.Sprintf("invalid profiler name %s, must contain alphanumeric characters, _ and - only，length greater than 0 and less than or equal to 128", msg))
 }
+
+func ErrTrusteeExists(codespace sdk.CodespaceType, trustee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeTrusteeExists, fmt.Sprintf("trustee %s already exists", trustee))
+}
+
+func ErrTrusteeNotExists(codespace sdk.CodespaceType, trustee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeTrusteeNotExists, fmt.Sprintf("trustee %s is not existed", trustee))
+}
+
+func ErrDeleteLastTrustee(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDeleteLastTrustee, "the last trustee can not be deleted")
+}
+
+func ErrDuplicateApproval(codespace sdk.CodespaceType, trustee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateApproval, fmt.Sprintf("trustee %s has already approved this trustee change", trustee))
+}
@@ -1,6 +1,8 @@
 package guardian
 
 import (
+	"strconv"
+
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/guardian/tags"
 )
@@ -11,6 +13,12 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgAddProfiler:
 			return handleMsgAddProfiler(ctx, k, msg)
+		case MsgDeleteProfiler:
+			return handleMsgDeleteProfiler(ctx, k, msg)
+		case MsgAddTrustee:
+			return handleMsgAddTrustee(ctx, k, msg)
+		case MsgDeleteTrustee:
+			return handleMsgDeleteTrustee(ctx, k, msg)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in guardian module").Result()
 		}
@@ -30,7 +38,88 @@ func handleMsgAddProfiler(ctx sdk.Context, k Keeper, msg MsgAddProfiler) sdk.Res
 	}
 	resTags := sdk.NewTags(
 		tags.Action, tags.ActionAddProfiler,
+		tags.Profiler, []byte(msg.Addr.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+// only a trustee can delete a profiler
+func handleMsgDeleteProfiler(ctx sdk.Context, k Keeper, msg MsgDeleteProfiler) sdk.Result {
+	if _, found := k.GetTrustee(ctx, msg.DeletedBy); !found {
+		return ErrTrusteeNotExists(DefaultCodespace, msg.DeletedBy).Result()
+	}
+	if _, found := k.GetProfiler(ctx, msg.Addr); !found {
+		return ErrProfilerNotExists(DefaultCodespace, msg.Addr).Result()
+	}
+	k.DeleteProfiler(ctx, msg.Addr)
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionDeleteProfiler,
+		tags.Profiler, []byte(msg.Addr.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+// a new trustee is added once more than half of the trustees approve it
+func handleMsgAddTrustee(ctx sdk.Context, k Keeper, msg MsgAddTrustee) sdk.Result {
+	if _, found := k.GetTrustee(ctx, msg.AddedAddr); !found {
+		return ErrTrusteeNotExists(DefaultCodespace, msg.AddedAddr).Result()
+	}
+	if _, found := k.GetTrustee(ctx, msg.Addr); found {
+		return ErrTrusteeExists(DefaultCodespace, msg.Addr).Result()
+	}
+	if !k.ApproveTrusteeChange(ctx, TrusteeChangeAdd, msg.Addr, msg.AddedAddr) {
+		return ErrDuplicateApproval(DefaultCodespace, msg.AddedAddr).Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionAddTrustee,
+		tags.Trustee, []byte(msg.Addr.String()),
+		tags.Approvals, []byte(strconv.Itoa(k.GetTrusteeChangeApprovals(ctx, TrusteeChangeAdd, msg.Addr))),
 	)
+	if !k.IsTrusteeChangeApproved(ctx, TrusteeChangeAdd, msg.Addr) {
+		return sdk.Result{
+			Tags: resTags,
+		}
+	}
+	k.DeleteTrusteeChangeApprovals(ctx, TrusteeChangeAdd, msg.Addr)
+	err := k.AddTrustee(ctx, msg.Trustee)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+// a trustee is deleted once more than half of the trustees approve it, the last trustee is kept
+func handleMsgDeleteTrustee(ctx sdk.Context, k Keeper, msg MsgDeleteTrustee) sdk.Result {
+	if _, found := k.GetTrustee(ctx, msg.DeletedBy); !found {
+		return ErrTrusteeNotExists(DefaultCodespace, msg.DeletedBy).Result()
+	}
+	if _, found := k.GetTrustee(ctx, msg.Addr); !found {
+		return ErrTrusteeNotExists(DefaultCodespace, msg.Addr).Result()
+	}
+	if k.GetTrusteesCount(ctx) <= 1 {
+		return ErrDeleteLastTrustee(DefaultCodespace).Result()
+	}
+	if !k.ApproveTrusteeChange(ctx, TrusteeChangeDelete, msg.Addr, msg.DeletedBy) {
+		return ErrDuplicateApproval(DefaultCodespace, msg.DeletedBy).Result()
+	}
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionDeleteTrustee,
+		tags.Trustee, []byte(msg.Addr.String()),
+		tags.Approvals, []byte(strconv.Itoa(k.GetTrusteeChangeApprovals(ctx, TrusteeChangeDelete, msg.Addr))),
+	)
+	if !k.IsTrusteeChangeApproved(ctx, TrusteeChangeDelete, msg.Addr) {
+		return sdk.Result{
+			Tags: resTags,
+		}
+	}
+	k.DeleteTrusteeChangeApprovals(ctx, TrusteeChangeDelete, msg.Addr)
+	k.DeleteTrustee(ctx, msg.Addr)
 	return sdk.Result{
 		Tags: resTags,
 	}
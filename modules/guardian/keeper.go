@@ -40,6 +40,12 @@ func (k Keeper) GetProfiler(ctx sdk.Context, addr sdk.AccAddress) (profiler Prof
 	return profiler, false
 }
 
+// Delete a profiler
+func (k Keeper) DeleteProfiler(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetProfilerKey(addr))
+}
+
 // Gets all profilers
 func (k Keeper) GetProfilers(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -64,8 +70,68 @@ func (k Keeper) GetTrustee(ctx sdk.Context, addr sdk.AccAddress) (trustee Truste
 	return trustee, false
 }
 
+// Delete a trustee
+func (k Keeper) DeleteTrustee(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetTrusteeKey(addr))
+}
+
 // Gets all trustees
 func (k Keeper) GetTrustees(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, GetTrusteesSubspaceKey())
 }
+
+// Returns the number of trustees
+func (k Keeper) GetTrusteesCount(ctx sdk.Context) (count int) {
+	iterator := k.GetTrustees(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// Approve a pending trustee change, returns false if the approver has already approved it
+func (k Keeper) ApproveTrusteeChange(ctx sdk.Context, change byte, addr, approver sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := GetTrusteeApprovalKey(change, addr, approver)
+	if store.Has(key) {
+		return false
+	}
+	store.Set(key, approver.Bytes())
+	return true
+}
+
+// Returns the number of approvals of a pending trustee change,
+// approvals of addresses which are no longer trustees are not counted
+func (k Keeper) GetTrusteeChangeApprovals(ctx sdk.Context, change byte, addr sdk.AccAddress) (count int) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetTrusteeApprovalsSubspaceKey(change, addr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if _, found := k.GetTrustee(ctx, sdk.AccAddress(iterator.Value())); found {
+			count++
+		}
+	}
+	return count
+}
+
+// Delete all approvals of a trustee change
+func (k Keeper) DeleteTrusteeChangeApprovals(ctx sdk.Context, change byte, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetTrusteeApprovalsSubspaceKey(change, addr))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// A trustee change is applied once more than half of the trustees approve it
+func (k Keeper) IsTrusteeChangeApproved(ctx sdk.Context, change byte, addr sdk.AccAddress) bool {
+	return k.GetTrusteeChangeApprovals(ctx, change, addr)*2 > k.GetTrusteesCount(ctx)
+}
@@ -7,6 +7,14 @@ import (
 var (
 	profilerKey = []byte{0x00}
 	trusteeKey  = []byte{0x01}
+
+	trusteeApprovalKey = []byte{0x02}
+)
+
+// the kinds of trustee changes that need approval
+const (
+	TrusteeChangeAdd    byte = 0x01
+	TrusteeChangeDelete byte = 0x02
 )
 
 func GetProfilerKey(addr sdk.AccAddress) []byte {
@@ -26,3 +34,14 @@ func GetProfilersSubspaceKey() []byte {
 func GetTrusteesSubspaceKey() []byte {
 	return trusteeKey
 }
+
+// Key for the approval of a trustee change by a trustee
+func GetTrusteeApprovalKey(change byte, addr, approver sdk.AccAddress) []byte {
+	return append(GetTrusteeApprovalsSubspaceKey(change, addr), approver.Bytes()...)
+}
+
+// Key for getting all approvals of a trustee change from the store
+func GetTrusteeApprovalsSubspaceKey(change byte, addr sdk.AccAddress) []byte {
+	key := append(append([]byte{}, trusteeApprovalKey...), change)
+	return append(key, addr.Bytes()...)
+}
@@ -3,6 +3,8 @@ package guardian
 import (
 	"testing"
 	"github.com/stretchr/testify/require"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestKeeper_AddProfiler(t *testing.T) {
@@ -22,3 +24,107 @@ func TestKeeper_AddTrustee(t *testing.T) {
 	require.True(t, found)
 	require.True(t, TrusteeEqual(trustee, AddedTrustee))
 }
+
+func TestHandler_ManageGuardians(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewHandler(keeper)
+	keeper.AddTrustee(ctx, NewTrustee(addrs[0]))
+	keeper.AddProfiler(ctx, NewProfiler(addrs[1], addrs[0]))
+
+	// only a trustee can add a trustee
+	res := handler(ctx, NewMsgAddTrustee(addrs[2], addrs[1]))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgAddTrustee(addrs[2], addrs[0]))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgAddTrustee(addrs[2], addrs[0]))
+	require.False(t, res.IsOK())
+	require.Equal(t, 2, keeper.GetTrusteesCount(ctx))
+
+	// only a trustee can delete a profiler
+	res = handler(ctx, NewMsgDeleteProfiler(addrs[1], addrs[1]))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgDeleteProfiler(addrs[1], addrs[2]))
+	require.True(t, res.IsOK())
+	_, found := keeper.GetProfiler(ctx, addrs[1])
+	require.False(t, found)
+
+	// a trustee is deleted once more than half of the trustees approve it
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[0], addrs[2]))
+	require.True(t, res.IsOK())
+	_, found = keeper.GetTrustee(ctx, addrs[0])
+	require.True(t, found)
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[0], addrs[0]))
+	require.True(t, res.IsOK())
+	_, found = keeper.GetTrustee(ctx, addrs[0])
+	require.False(t, found)
+
+	// the last trustee can not be deleted
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[2], addrs[2]))
+	require.False(t, res.IsOK())
+	_, found = keeper.GetTrustee(ctx, addrs[2])
+	require.True(t, found)
+
+	querier := NewQuerier(keeper)
+	bz, err := querier(ctx, []string{QueryTrustees}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var trustees []Trustee
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &trustees))
+	require.Equal(t, 1, len(trustees))
+	require.True(t, TrusteeEqual(NewTrustee(addrs[2]), trustees[0]))
+
+	bz, err = querier(ctx, []string{QueryProfilers}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var profilers []Profiler
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &profilers))
+	require.Equal(t, 0, len(profilers))
+}
+
+func TestHandler_TrusteeChangeApprovals(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewHandler(keeper)
+	keeper.AddTrustee(ctx, NewTrustee(addrs[0]))
+	keeper.AddTrustee(ctx, NewTrustee(addrs[1]))
+	keeper.AddTrustee(ctx, NewTrustee(addrs[2]))
+
+	// a single trustee can not add a trustee
+	res := handler(ctx, NewMsgAddTrustee(addrs[3], addrs[0]))
+	require.True(t, res.IsOK())
+	_, found := keeper.GetTrustee(ctx, addrs[3])
+	require.False(t, found)
+	require.Equal(t, 1, keeper.GetTrusteeChangeApprovals(ctx, TrusteeChangeAdd, addrs[3]))
+
+	// a trustee can approve a change only once
+	res = handler(ctx, NewMsgAddTrustee(addrs[3], addrs[0]))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeDuplicateApproval), res.Code)
+
+	res = handler(ctx, NewMsgAddTrustee(addrs[3], addrs[1]))
+	require.True(t, res.IsOK())
+	_, found = keeper.GetTrustee(ctx, addrs[3])
+	require.True(t, found)
+	require.Equal(t, 0, keeper.GetTrusteeChangeApprovals(ctx, TrusteeChangeAdd, addrs[3]))
+
+	// a single trustee can not delete the other trustees
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[1], addrs[0]))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[2], addrs[0]))
+	require.True(t, res.IsOK())
+	require.Equal(t, 4, keeper.GetTrusteesCount(ctx))
+
+	// half of the trustees is not enough
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[0], addrs[1]))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[0], addrs[2]))
+	require.True(t, res.IsOK())
+	require.Equal(t, 2, keeper.GetTrusteeChangeApprovals(ctx, TrusteeChangeDelete, addrs[0]))
+	_, found = keeper.GetTrustee(ctx, addrs[0])
+	require.True(t, found)
+	res = handler(ctx, NewMsgDeleteTrustee(addrs[0], addrs[3]))
+	require.True(t, res.IsOK())
+	_, found = keeper.GetTrustee(ctx, addrs[0])
+	require.False(t, found)
+	require.Equal(t, 3, keeper.GetTrusteesCount(ctx))
+
+	// approvals of a deleted trustee are not counted
+	require.Equal(t, 0, keeper.GetTrusteeChangeApprovals(ctx, TrusteeChangeDelete, addrs[1]))
+	require.Equal(t, 0, keeper.GetTrusteeChangeApprovals(ctx, TrusteeChangeDelete, addrs[2]))
+}
@@ -49,6 +49,119 @@ func (msg MsgAddProfiler) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.AddedAddr}
 }
 
+//______________________________________________________________________
+// MsgDeleteProfiler - struct for delete a profiler
+type MsgDeleteProfiler struct {
+	Addr      sdk.AccAddress `json:"addr"`       // the profiler to delete
+	DeletedBy sdk.AccAddress `json:"deleted_by"` // the trustee deleting the profiler
+}
+
+func NewMsgDeleteProfiler(addr, deletedBy sdk.AccAddress) MsgDeleteProfiler {
+	return MsgDeleteProfiler{
+		Addr:      addr,
+		DeletedBy: deletedBy,
+	}
+}
+func (msg MsgDeleteProfiler) Route() string { return MsgType }
+func (msg MsgDeleteProfiler) Type() string  { return "guardian delete-profiler" }
+func (msg MsgDeleteProfiler) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDeleteProfiler) ValidateBasic() sdk.Error {
+	if len(msg.Addr) == 0 {
+		return sdk.ErrInvalidAddress(msg.Addr.String())
+	}
+	if len(msg.DeletedBy) == 0 {
+		return sdk.ErrInvalidAddress(msg.DeletedBy.String())
+	}
+	return nil
+}
+
+func (msg MsgDeleteProfiler) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DeletedBy}
+}
+
+//______________________________________________________________________
+// MsgAddTrustee - struct for add a trustee
+type MsgAddTrustee struct {
+	Trustee
+	AddedAddr sdk.AccAddress `json:"added_addr"` // the trustee adding the new one
+}
+
+func NewMsgAddTrustee(addr, addedAddr sdk.AccAddress) MsgAddTrustee {
+	return MsgAddTrustee{
+		Trustee: Trustee{
+			Addr: addr,
+		},
+		AddedAddr: addedAddr,
+	}
+}
+func (msg MsgAddTrustee) Route() string { return MsgType }
+func (msg MsgAddTrustee) Type() string  { return "guardian add-trustee" }
+func (msg MsgAddTrustee) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgAddTrustee) ValidateBasic() sdk.Error {
+	if len(msg.Addr) == 0 {
+		return sdk.ErrInvalidAddress(msg.Addr.String())
+	}
+	if len(msg.AddedAddr) == 0 {
+		return sdk.ErrInvalidAddress(msg.AddedAddr.String())
+	}
+	return nil
+}
+
+func (msg MsgAddTrustee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.AddedAddr}
+}
+
+//______________________________________________________________________
+// MsgDeleteTrustee - struct for delete a trustee
+type MsgDeleteTrustee struct {
+	Addr      sdk.AccAddress `json:"addr"`       // the trustee to delete
+	DeletedBy sdk.AccAddress `json:"deleted_by"` // the trustee deleting it, can be the trustee itself
+}
+
+func NewMsgDeleteTrustee(addr, deletedBy sdk.AccAddress) MsgDeleteTrustee {
+	return MsgDeleteTrustee{
+		Addr:      addr,
+		DeletedBy: deletedBy,
+	}
+}
+func (msg MsgDeleteTrustee) Route() string { return MsgType }
+func (msg MsgDeleteTrustee) Type() string  { return "guardian delete-trustee" }
+func (msg MsgDeleteTrustee) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDeleteTrustee) ValidateBasic() sdk.Error {
+	if len(msg.Addr) == 0 {
+		return sdk.ErrInvalidAddress(msg.Addr.String())
+	}
+	if len(msg.DeletedBy) == 0 {
+		return sdk.ErrInvalidAddress(msg.DeletedBy.String())
+	}
+	return nil
+}
+
+func (msg MsgDeleteTrustee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DeletedBy}
+}
+
 //______________________________________________________________________
 
 func validName(name string) bool {
@@ -0,0 +1,62 @@
+package guardian
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the guardian Querier
+const (
+	QueryProfilers = "profilers"
+	QueryTrustees  = "trustees"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryProfilers:
+			return queryProfilers(ctx, path[1:], req, keeper)
+		case QueryTrustees:
+			return queryTrustees(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown guardian query endpoint")
+		}
+	}
+}
+
+// nolint: unparam
+func queryProfilers(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	profilers := []Profiler{}
+	iterator := keeper.GetProfilers(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var profiler Profiler
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &profiler)
+		profilers = append(profilers, profiler)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, profilers)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryTrustees(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	trustees := []Trustee{}
+	iterator := keeper.GetTrustees(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var trustee Trustee
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &trustee)
+		trustees = append(trustees, trustee)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, trustees)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
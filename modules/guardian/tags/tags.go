@@ -5,7 +5,13 @@ import (
 )
 
 var (
-	ActionAddProfiler = []byte("add-profiler")
+	ActionAddProfiler    = []byte("add-profiler")
+	ActionDeleteProfiler = []byte("delete-profiler")
+	ActionAddTrustee     = []byte("add-trustee")
+	ActionDeleteTrustee  = []byte("delete-trustee")
 
-	Action = sdk.TagAction
+	Action    = sdk.TagAction
+	Profiler  = "profiler"
+	Trustee   = "trustee"
+	Approvals = "approvals"
 )
@@ -24,11 +24,13 @@ var (
 		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB50"),
 		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB51"),
 		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB52"),
+		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB53"),
 	}
 	addrs = []sdk.AccAddress{
 		sdk.AccAddress(pks[0].Address()),
 		sdk.AccAddress(pks[1].Address()),
 		sdk.AccAddress(pks[2].Address()),
+		sdk.AccAddress(pks[3].Address()),
 	}
 )
 
@@ -16,11 +16,16 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	}
 
 	params := k.GetParams(ctx)
+	// the inflation is based on the bank supply once it is tracked
 	totalSupply := k.sk.TotalPower(ctx)
+	if k.bk.IsSupplyTracked(ctx) {
+		totalSupply = sdk.NewDecFromInt(k.bk.GetSupply(ctx, params.MintDenom))
+	}
 	bondedRatio := k.sk.BondedRatio(ctx)
 	minter.InflationLastTime = blockTime
 	minter, mintedCoin := minter.ProcessProvisions(params, totalSupply, bondedRatio)
 	k.fck.AddCollectedFees(ctx, sdk.Coins{mintedCoin})
+	k.bk.IncreaseSupply(ctx, sdk.Coins{mintedCoin})
 	k.sk.InflateSupply(ctx, sdk.NewDecFromInt(mintedCoin.Amount))
 	k.SetMinter(ctx, minter)
 }
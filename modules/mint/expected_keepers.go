@@ -11,6 +11,13 @@ type StakeKeeper interface {
 	InflateSupply(ctx sdk.Context, newTokens sdk.Dec)
 }
 
+// expected bank keeper
+type BankKeeper interface {
+	IsSupplyTracked(ctx sdk.Context) bool
+	GetSupply(ctx sdk.Context, denom string) sdk.Int
+	IncreaseSupply(ctx sdk.Context, amt sdk.Coins)
+}
+
 // expected fee collection keeper interface
 type FeeCollectionKeeper interface {
 	AddCollectedFees(sdk.Context, sdk.Coins) sdk.Coins
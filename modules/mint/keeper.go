@@ -12,17 +12,19 @@ type Keeper struct {
 	cdc        *codec.Codec
 	paramSpace params.Subspace
 	sk         StakeKeeper
+	bk         BankKeeper
 	fck        FeeCollectionKeeper
 }
 
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramSpace params.Subspace, sk StakeKeeper, fck FeeCollectionKeeper) Keeper {
+	paramSpace params.Subspace, sk StakeKeeper, bk BankKeeper, fck FeeCollectionKeeper) Keeper {
 
 	keeper := Keeper{
 		storeKey:   key,
 		cdc:        cdc,
 		paramSpace: paramSpace.WithTypeTable(ParamTypeTable()),
 		sk:         sk,
+		bk:         bk,
 		fck:        fck,
 	}
 	return keeper
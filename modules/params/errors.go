@@ -23,4 +23,12 @@ const (
 	CodeInvalidQueryParams              sdk.CodeType      = 114
 	CodeInvalidMaxRequestTimeout        sdk.CodeType      = 115
 	CodeInvalidMinDepositMultiple       sdk.CodeType      = 116
+	CodeInvalidSlashFraction            sdk.CodeType      = 117
+	CodeInvalidProposalProcedure        sdk.CodeType      = 118
+	CodeInvalidDepositPolicy            sdk.CodeType      = 119
+	CodeInvalidSwitchPeriod             sdk.CodeType      = 120
+	CodeInvalidSwitchThreshold          sdk.CodeType      = 121
+	CodeInvalidIssueFee                 sdk.CodeType      = 122
+	CodeInvalidMintFee                  sdk.CodeType      = 123
+	CodeInvalidFeeExchangeRate          sdk.CodeType      = 124
 )
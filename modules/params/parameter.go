@@ -43,5 +43,8 @@ type GovArrayParameter interface {
 
 	LoadValueByKey(ctx sdk.Context, key string) bool
 
-	Insert(ctx sdk.Context, json string)
+	// Valid checks the whole array of an update, ValidInsert a single element to insert
+	ValidInsert(json string) sdk.Error
+
+	Insert(ctx sdk.Context, json string) sdk.Error
 }
@@ -13,6 +13,12 @@ const (
 	CodeInvalidDataSize        sdk.CodeType = 1
 	CodeInvalidFileDescription sdk.CodeType = 2
 	CodeInvalidDataHash        sdk.CodeType = 3
+	CodeUnknownRecord          sdk.CodeType = 4
+	CodeRecordExists           sdk.CodeType = 5
+	CodeNotRecordOwner         sdk.CodeType = 6
+	CodeRecordNotActive        sdk.CodeType = 7
+	CodeInvalidRevokeReason    sdk.CodeType = 8
+	CodeInvalidDataURI         sdk.CodeType = 9
 )
 
 func ErrInvalidDataSize(codespace sdk.CodespaceType, limit int64) sdk.Error {
@@ -26,3 +32,27 @@ func ErrInvalidDescription(codespace sdk.CodespaceType, limit int64) sdk.Error {
 func ErrInvalidDataHash(codespace sdk.CodespaceType, hash string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDataHash, fmt.Sprintf("Data hash [%s] is invalid", hash))
 }
+
+func ErrUnknownRecord(codespace sdk.CodespaceType, recordID string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownRecord, fmt.Sprintf("Record ID [%s] doesn't exist", recordID))
+}
+
+func ErrRecordExists(codespace sdk.CodespaceType, recordID string) sdk.Error {
+	return sdk.NewError(codespace, CodeRecordExists, fmt.Sprintf("Record ID [%s] already exists", recordID))
+}
+
+func ErrNotRecordOwner(codespace sdk.CodespaceType, recordID string, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotRecordOwner, fmt.Sprintf("[%s] is not the owner of record [%s]", address, recordID))
+}
+
+func ErrRecordNotActive(codespace sdk.CodespaceType, recordID string, status RecordStatus) sdk.Error {
+	return sdk.NewError(codespace, CodeRecordNotActive, fmt.Sprintf("Record ID [%s] is %s", recordID, status))
+}
+
+func ErrInvalidRevokeReason(codespace sdk.CodespaceType, limit int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRevokeReason, fmt.Sprintf("Revoke reason limit is %d bytes", limit))
+}
+
+func ErrInvalidDataURI(codespace sdk.CodespaceType, limit int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDataURI, fmt.Sprintf("Offchain record can't have onchain data and data URI limit is %d bytes", limit))
+}
@@ -11,6 +11,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSubmitRecord:
 			return handleMsgSubmitFile(ctx, keeper, msg)
+		case MsgAmendRecord:
+			return handleMsgAmendRecord(ctx, keeper, msg)
+		case MsgSupersedeRecord:
+			return handleMsgSupersedeRecord(ctx, keeper, msg)
+		case MsgRevokeRecord:
+			return handleMsgRevokeRecord(ctx, keeper, msg)
 		default:
 			errMsg := "Unrecognized record msg type"
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -20,7 +26,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 func handleMsgSubmitFile(ctx sdk.Context, keeper Keeper, msg MsgSubmitRecord) sdk.Result {
 
-	keeper.AddRecord(ctx, msg)
+	err := keeper.SubmitRecord(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
 
 	recordIDBytes := []byte(msg.RecordID)
 
@@ -35,3 +44,55 @@ func handleMsgSubmitFile(ctx sdk.Context, keeper Keeper, msg MsgSubmitRecord) sd
 		Tags: resTags,
 	}
 }
+
+func handleMsgAmendRecord(ctx sdk.Context, keeper Keeper, msg MsgAmendRecord) sdk.Result {
+	err := keeper.AmendRecord(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionAmendRecord,
+		tags.OwnerAddress, []byte(msg.OwnerAddress.String()),
+		tags.RecordID, []byte(msg.RecordID),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgSupersedeRecord(ctx sdk.Context, keeper Keeper, msg MsgSupersedeRecord) sdk.Result {
+	err := keeper.SupersedeRecord(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	newRecordIDBytes := []byte(msg.NewRecord.RecordID)
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionSupersedeRecord,
+		tags.OwnerAddress, []byte(msg.NewRecord.OwnerAddress.String()),
+		tags.RecordID, []byte(msg.RecordID),
+		tags.NewRecordID, newRecordIDBytes,
+	)
+	return sdk.Result{
+		Data: newRecordIDBytes,
+		Tags: resTags,
+	}
+}
+
+func handleMsgRevokeRecord(ctx sdk.Context, keeper Keeper, msg MsgRevokeRecord) sdk.Result {
+	err := keeper.RevokeRecord(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionRevokeRecord,
+		tags.OwnerAddress, []byte(msg.OwnerAddress.String()),
+		tags.RecordID, []byte(msg.RecordID),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
@@ -1,6 +1,7 @@
 package record
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/irisnet/irishub/types"
@@ -40,9 +41,234 @@ func KeyRecord(dataHash string) []byte {
 	return []byte(fmt.Sprintf("record:%s", dataHash))
 }
 
+// a record ID is the key of the record, an ID given by a user must not point at the other keys of the store
+func validRecordID(recordID string) bool {
+	prefix := KeyRecord("")
+	return len(recordID) > len(prefix) && bytes.HasPrefix([]byte(recordID), prefix)
+}
+
+// Key for getting all records of an owner from the store
+func KeyOwnerRecordsPrefix(owner sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("ownerRecord:%s:", owner.String()))
+}
+
+// Key of an owner record index, the records of an owner are ordered by the block time of their submission
+func KeyOwnerRecord(owner sdk.AccAddress, submitTime int64, recordID string) []byte {
+	key := append(KeyOwnerRecordsPrefix(owner), sdk.Uint64ToBigEndian(uint64(submitTime))...)
+	return append(key, []byte(recordID)...)
+}
+
+// Key of the lifecycle state of a record
+func KeyRecordState(recordID string) []byte {
+	return []byte(fmt.Sprintf("recordState:%s", recordID))
+}
+
+// Key for getting the history events of a record from the store
+func KeyRecordEventsPrefix(recordID string) []byte {
+	return []byte(fmt.Sprintf("recordEvent:%s:", recordID))
+}
+
+func KeyRecordEvent(recordID string, revision uint64) []byte {
+	return append(KeyRecordEventsPrefix(recordID), sdk.Uint64ToBigEndian(revision)...)
+}
+
+// Stores a record and indexes it by owner at the block time, the submit time of the msg is given by
+// the client and can't be trusted
 func (keeper Keeper) AddRecord(ctx sdk.Context, msg MsgSubmitRecord) {
 
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(msg)
 	store.Set(KeyRecord(msg.DataHash), bz)
+	store.Set(KeyOwnerRecord(msg.OwnerAddress, ctx.BlockHeader().Time.Unix(), msg.RecordID), []byte(msg.RecordID))
+}
+
+func (keeper Keeper) GetRecord(ctx sdk.Context, recordID string) (record MsgSubmitRecord, found bool) {
+	if !validRecordID(recordID) {
+		return record, false
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get([]byte(recordID))
+	if bz == nil {
+		return record, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// Returns an iterator over the record IDs of an owner submitted within [startTime, endTime],
+// a zero endTime means no upper bound
+func (keeper Keeper) OwnerRecordsIterator(ctx sdk.Context, owner sdk.AccAddress, startTime, endTime int64) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	end := sdk.PrefixEndBytes(KeyOwnerRecordsPrefix(owner))
+	if endTime > 0 {
+		end = KeyOwnerRecord(owner, endTime+1, "")
+	}
+	return store.Iterator(KeyOwnerRecord(owner, startTime, ""), end)
+}
+
+// Indexes by owner the records stored before the owner index existed. The block time of their
+// submission is unknown, they are indexed at their submit time but no later than the current block time.
+func (keeper Keeper) IndexOwnerRecords(ctx sdk.Context) error {
+	store := ctx.KVStore(keeper.storeKey)
+
+	indexed := make(map[string]bool)
+	indexIterator := sdk.KVStorePrefixIterator(store, []byte("ownerRecord:"))
+	for ; indexIterator.Valid(); indexIterator.Next() {
+		indexed[string(indexIterator.Value())] = true
+	}
+	indexIterator.Close()
+
+	var records []MsgSubmitRecord
+	iterator := sdk.KVStorePrefixIterator(store, KeyRecord(""))
+	for ; iterator.Valid(); iterator.Next() {
+		var record MsgSubmitRecord
+		if err := keeper.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &record); err != nil {
+			iterator.Close()
+			return err
+		}
+		if !indexed[record.RecordID] {
+			records = append(records, record)
+		}
+	}
+	iterator.Close()
+
+	blockTime := ctx.BlockHeader().Time.Unix()
+	for _, record := range records {
+		submitTime := record.SubmitTime
+		if submitTime > blockTime {
+			submitTime = blockTime
+		}
+		if submitTime < 0 {
+			submitTime = 0
+		}
+		store.Set(KeyOwnerRecord(record.OwnerAddress, submitTime, record.RecordID), []byte(record.RecordID))
+	}
+	return nil
+}
+
+// Submit a new record, a record can not be submitted twice
+func (keeper Keeper) SubmitRecord(ctx sdk.Context, msg MsgSubmitRecord) sdk.Error {
+	return keeper.submitRecord(ctx, msg, 1, "")
+}
+
+func (keeper Keeper) submitRecord(ctx sdk.Context, msg MsgSubmitRecord, version uint64, prevRecordID string) sdk.Error {
+	if keeper.HasRecord(ctx, msg.RecordID) {
+		return ErrRecordExists(keeper.codespace, msg.RecordID)
+	}
+	keeper.AddRecord(ctx, msg)
+
+	state := NewRecordState(msg.RecordID, msg.OwnerAddress, version, prevRecordID, ctx.BlockHeight())
+	keeper.addRecordEvent(ctx, &state, RecordEvent{Action: EventSubmit})
+	return nil
+}
+
+// Amend the description of an active record
+func (keeper Keeper) AmendRecord(ctx sdk.Context, msg MsgAmendRecord) sdk.Error {
+	record, state, err := keeper.getActiveRecord(ctx, msg.RecordID, msg.OwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	event := RecordEvent{Action: EventAmend, OldDescription: record.Description, NewDescription: msg.Description}
+	record.Description = msg.Description
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set([]byte(msg.RecordID), keeper.cdc.MustMarshalBinaryLengthPrefixed(record))
+
+	keeper.addRecordEvent(ctx, &state, event)
+	return nil
+}
+
+// Supersede an active record with a new one, which becomes the next version in the chain
+func (keeper Keeper) SupersedeRecord(ctx sdk.Context, msg MsgSupersedeRecord) sdk.Error {
+	_, state, err := keeper.getActiveRecord(ctx, msg.RecordID, msg.NewRecord.OwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.submitRecord(ctx, msg.NewRecord, state.Version+1, state.RecordID)
+	if err != nil {
+		return err
+	}
+
+	state.Status = StatusSuperseded
+	state.NextRecordID = msg.NewRecord.RecordID
+	keeper.addRecordEvent(ctx, &state, RecordEvent{Action: EventSupersede, NewRecordID: msg.NewRecord.RecordID})
+	return nil
+}
+
+// Revoke an active record, the revoked record is kept for auditing
+func (keeper Keeper) RevokeRecord(ctx sdk.Context, msg MsgRevokeRecord) sdk.Error {
+	_, state, err := keeper.getActiveRecord(ctx, msg.RecordID, msg.OwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	state.Status = StatusRevoked
+	keeper.addRecordEvent(ctx, &state, RecordEvent{Action: EventRevoke, Reason: msg.Reason})
+	return nil
+}
+
+func (keeper Keeper) getActiveRecord(ctx sdk.Context, recordID string, owner sdk.AccAddress) (MsgSubmitRecord, RecordState, sdk.Error) {
+	record, found := keeper.GetRecord(ctx, recordID)
+	if !found {
+		return record, RecordState{}, ErrUnknownRecord(keeper.codespace, recordID)
+	}
+	state, _ := keeper.GetRecordState(ctx, recordID)
+	if !state.Owner.Equals(owner) {
+		return record, state, ErrNotRecordOwner(keeper.codespace, recordID, owner)
+	}
+	if state.Status != StatusActive {
+		return record, state, ErrRecordNotActive(keeper.codespace, recordID, state.Status)
+	}
+	return record, state, nil
+}
+
+func (keeper Keeper) HasRecord(ctx sdk.Context, recordID string) bool {
+	if !validRecordID(recordID) {
+		return false
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has([]byte(recordID))
+}
+
+// Gets the lifecycle state of a record, the records submitted before the state was introduced are active
+// first versions
+func (keeper Keeper) GetRecordState(ctx sdk.Context, recordID string) (state RecordState, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyRecordState(recordID))
+	if bz != nil {
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &state)
+		return state, true
+	}
+
+	record, found := keeper.GetRecord(ctx, recordID)
+	if !found {
+		return state, false
+	}
+	return NewRecordState(recordID, record.OwnerAddress, 1, "", 0), true
+}
+
+func (keeper Keeper) SetRecordState(ctx sdk.Context, state RecordState) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(state)
+	store.Set(KeyRecordState(state.RecordID), bz)
+}
+
+// Appends an event to the history of a record and saves the updated state
+func (keeper Keeper) addRecordEvent(ctx sdk.Context, state *RecordState, event RecordEvent) {
+	state.Revision++
+	state.UpdateHeight = ctx.BlockHeight()
+	keeper.SetRecordState(ctx, *state)
+
+	event.Height = ctx.BlockHeight()
+	event.Owner = state.Owner
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(event)
+	store.Set(KeyRecordEvent(state.RecordID, state.Revision), bz)
+}
+
+// Gets the history events of a record in order
+func (keeper Keeper) GetRecordEvents(ctx sdk.Context, recordID string) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return sdk.KVStorePrefixIterator(store, KeyRecordEventsPrefix(recordID))
 }
@@ -36,3 +36,174 @@ func TestAddRecord(t *testing.T) {
 	require.True(t, recordEqual(record1, record2))
 
 }
+
+func TestQueryRecordsByOwner(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	querier := NewQuerier(keeper)
+
+	// the records are indexed at the block time, the submit time given by the client is ignored
+	for i, data := range []string{"record data 1", "record data 2", "record data 3"} {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(int64(1000+i), 0)})
+		keeper.AddRecord(ctx, NewMsgSubmitRecord("record description", int64(5000-i), addrs[0],
+			getDataHash(data), int64(binary.Size([]byte(data))), data))
+	}
+	data := "record data 4"
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1001, 0)})
+	keeper.AddRecord(ctx, NewMsgSubmitRecord("record description", 1001, addrs[1],
+		getDataHash(data), int64(binary.Size([]byte(data))), data))
+
+	record, found := keeper.GetRecord(ctx, string(KeyRecord(getDataHash("record data 2"))))
+	require.True(t, found)
+	require.Equal(t, "record data 2", record.Data)
+
+	_, err := querier(ctx, []string{QueryRecord}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryRecordParams{RecordID: string(KeyRecord(getDataHash("unknown")))}),
+	})
+	require.NotNil(t, err)
+
+	// an ID pointing at another key of the store is not a record
+	indexKey := KeyOwnerRecord(addrs[1], 1001, string(KeyRecord(getDataHash("record data 4"))))
+	require.NotNil(t, ctx.KVStore(keeper.storeKey).Get(indexKey))
+	require.NotPanics(t, func() {
+		_, found = keeper.GetRecord(ctx, string(indexKey))
+		_, err = querier(ctx, []string{QueryRecord}, abci.RequestQuery{
+			Data: keeper.cdc.MustMarshalJSON(QueryRecordParams{RecordID: string(indexKey)}),
+		})
+	})
+	require.False(t, found)
+	require.NotNil(t, err)
+	require.False(t, keeper.HasRecord(ctx, string(indexKey)))
+
+	var records []MsgSubmitRecord
+	bz, err := querier(ctx, []string{QueryRecords}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryRecordsParams{Owner: addrs[0]}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &records)
+	require.Equal(t, 3, len(records))
+	require.Equal(t, "record data 1", records[0].Data)
+
+	// time range filter
+	bz, err = querier(ctx, []string{QueryRecords}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryRecordsParams{Owner: addrs[0], StartTime: 1001, EndTime: 1001}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &records)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, "record data 2", records[0].Data)
+
+	// pagination
+	bz, err = querier(ctx, []string{QueryRecords}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryRecordsParams{Owner: addrs[0], Page: 2, Limit: 2}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &records)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, "record data 3", records[0].Data)
+}
+
+func TestIndexOwnerRecords(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 10, Time: time.Unix(1500, 0)})
+	querier := NewQuerier(keeper)
+
+	// records stored before the owner index existed, one with a submit time in the future
+	store := ctx.KVStore(keeper.storeKey)
+	var legacy []MsgSubmitRecord
+	for i, data := range []string{"legacy record data 1", "legacy record data 2"} {
+		record := NewMsgSubmitRecord("record description", int64(1000+8000*i), addrs[0],
+			getDataHash(data), int64(binary.Size([]byte(data))), data)
+		store.Set(KeyRecord(record.DataHash), keeper.cdc.MustMarshalBinaryLengthPrefixed(record))
+		legacy = append(legacy, record)
+	}
+
+	data := "new record data"
+	record := NewMsgSubmitRecord("record description", 100, addrs[0],
+		getDataHash(data), int64(binary.Size([]byte(data))), data)
+	require.Nil(t, keeper.SubmitRecord(ctx, record))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 12, Time: time.Unix(2000, 0)})
+	require.Nil(t, keeper.IndexOwnerRecords(ctx))
+
+	queryRecords := func(startTime, endTime int64) []MsgSubmitRecord {
+		var records []MsgSubmitRecord
+		bz, err := querier(ctx, []string{QueryRecords}, abci.RequestQuery{
+			Data: keeper.cdc.MustMarshalJSON(QueryRecordsParams{Owner: addrs[0], StartTime: startTime, EndTime: endTime}),
+		})
+		require.Nil(t, err)
+		keeper.cdc.MustUnmarshalJSON(bz, &records)
+		return records
+	}
+
+	// the legacy records are indexed at their submit time, no later than the migration,
+	// the indexed record keeps its block time and isn't indexed twice
+	records := queryRecords(0, 0)
+	require.Equal(t, 3, len(records))
+	require.Equal(t, legacy[0].RecordID, records[0].RecordID)
+	require.Equal(t, record.RecordID, records[1].RecordID)
+	require.Equal(t, legacy[1].RecordID, records[2].RecordID)
+
+	records = queryRecords(2000, 2000)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, legacy[1].RecordID, records[0].RecordID)
+}
+
+func TestRecordLifecycle(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	newRecord := func(data string, owner int) MsgSubmitRecord {
+		return NewMsgSubmitRecord("record description", time.Now().Unix(), addrs[owner],
+			getDataHash(data), int64(binary.Size([]byte(data))), data)
+	}
+	record1 := newRecord("record data v1", 0)
+	require.Nil(t, keeper.SubmitRecord(ctx, record1))
+
+	// duplicate submission, even from another owner, is rejected
+	require.NotNil(t, keeper.SubmitRecord(ctx, record1))
+	require.NotNil(t, keeper.SubmitRecord(ctx, newRecord("record data v1", 1)))
+
+	// only the owner can amend
+	require.NotNil(t, keeper.AmendRecord(ctx, NewMsgAmendRecord(record1.RecordID, addrs[1], "new description")))
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 11})
+	require.Nil(t, keeper.AmendRecord(ctx, NewMsgAmendRecord(record1.RecordID, addrs[0], "new description")))
+	amended, _ := keeper.GetRecord(ctx, record1.RecordID)
+	require.Equal(t, "new description", amended.Description)
+
+	// supersede with a new version
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 12})
+	record2 := newRecord("record data v2", 0)
+	require.Nil(t, keeper.SupersedeRecord(ctx, NewMsgSupersedeRecord(record1.RecordID, record2)))
+	require.NotNil(t, keeper.AmendRecord(ctx, NewMsgAmendRecord(record1.RecordID, addrs[0], "description")))
+
+	state1, _ := keeper.GetRecordState(ctx, record1.RecordID)
+	require.Equal(t, StatusSuperseded, state1.Status)
+	require.Equal(t, record2.RecordID, state1.NextRecordID)
+	require.Equal(t, int64(12), state1.UpdateHeight)
+	state2, _ := keeper.GetRecordState(ctx, record2.RecordID)
+	require.Equal(t, uint64(2), state2.Version)
+	require.Equal(t, record1.RecordID, state2.PrevRecordID)
+	require.Equal(t, int64(12), state2.SubmitHeight)
+
+	// revoke the latest version
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 13})
+	require.Nil(t, keeper.RevokeRecord(ctx, NewMsgRevokeRecord(record2.RecordID, addrs[0], "obsolete")))
+	require.NotNil(t, keeper.RevokeRecord(ctx, NewMsgRevokeRecord(record2.RecordID, addrs[0], "obsolete")))
+
+	var history RecordHistory
+	bz, err := NewQuerier(keeper)(ctx, []string{QueryHistory}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryRecordParams{RecordID: record1.RecordID}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &history)
+	require.Equal(t, []string{record1.RecordID, record2.RecordID}, history.Versions)
+	require.Equal(t, 3, len(history.Events))
+	require.Equal(t, EventSubmit, history.Events[0].Action)
+	require.Equal(t, int64(10), history.Events[0].Height)
+	require.Equal(t, "record description", history.Events[1].OldDescription)
+	require.Equal(t, EventSupersede, history.Events[2].Action)
+}
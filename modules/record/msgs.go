@@ -1,7 +1,9 @@
 package record
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 
 	sdk "github.com/irisnet/irishub/types"
@@ -13,6 +15,8 @@ const MsgType = "record"
 const (
 	UploadLimitOfOnchain     = 1024 // Data field upload limit in bytes(1K currently)
 	UploadLimitOfDescription = 1024 // Description field upload limit in bytes(1K currently)
+	LimitOfRevokeReason      = 1024 // Revoke reason limit in bytes(1K currently)
+	LimitOfDataURI           = 512  // DataURI field limit in bytes
 )
 
 //-----------------------------------------------------------
@@ -25,6 +29,7 @@ type MsgSubmitRecord struct {
 	DataHash     string         // Data/file hash
 	DataSize     int64          // Data/file Size in bytes
 	Data         string         // Onchain data
+	DataURI      string         `json:",omitempty"` // Offchain data location, omitted from the sign bytes of onchain records
 }
 
 func NewMsgSubmitRecord(description string,
@@ -44,6 +49,24 @@ func NewMsgSubmitRecord(description string,
 	}
 }
 
+// the data of an offchain record is kept in a storage backend located by dataURI
+func NewMsgSubmitOffchainRecord(description string,
+	submitTime int64,
+	ownerAddress sdk.AccAddress,
+	dataHash string,
+	dataSize int64,
+	dataURI string) MsgSubmitRecord {
+	return MsgSubmitRecord{
+		Description:  description,
+		SubmitTime:   submitTime,
+		OwnerAddress: ownerAddress,
+		DataHash:     dataHash,
+		DataSize:     dataSize,
+		RecordID:     string(KeyRecord(dataHash)),
+		DataURI:      dataURI,
+	}
+}
+
 // Implements Msg.
 func (msg MsgSubmitRecord) Route() string { return MsgType }
 func (msg MsgSubmitRecord) Type() string  { return "submit_record" }
@@ -57,12 +80,27 @@ func (msg MsgSubmitRecord) ValidateBasic() sdk.Error {
 		return ErrInvalidDescription(DefaultCodespace, UploadLimitOfDescription)
 	}
 
-	if msg.DataSize == 0 ||
-		msg.DataSize > UploadLimitOfOnchain {
-		return ErrInvalidDataSize(DefaultCodespace, UploadLimitOfOnchain)
+	if len(msg.DataURI) == 0 {
+		if msg.DataSize == 0 ||
+			msg.DataSize > UploadLimitOfOnchain {
+			return ErrInvalidDataSize(DefaultCodespace, UploadLimitOfOnchain)
+		}
+	} else {
+		// the offchain data is verified against the data hash when downloaded
+		if len(msg.Data) != 0 || len(msg.DataURI) > LimitOfDataURI {
+			return ErrInvalidDataURI(DefaultCodespace, LimitOfDataURI)
+		}
+		if msg.DataSize <= 0 {
+			return ErrInvalidDataSize(DefaultCodespace, UploadLimitOfOnchain)
+		}
 	}
 
-	if len(msg.DataHash) == 0 {
+	// the data hash is part of the record ID and of the keys derived from it,
+	// a hex encoded sha256 hash keeps the keys of different records apart
+	if hash, err := hex.DecodeString(msg.DataHash); err != nil || len(hash) != sha256.Size {
+		return ErrInvalidDataHash(DefaultCodespace, msg.DataHash)
+	}
+	if msg.RecordID != string(KeyRecord(msg.DataHash)) {
 		return ErrInvalidDataHash(DefaultCodespace, msg.DataHash)
 	}
 
@@ -99,3 +137,162 @@ func (msg MsgSubmitRecord) GetSignBytes() []byte {
 func (msg MsgSubmitRecord) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
+
+//-----------------------------------------------------------
+// MsgAmendRecord
+type MsgAmendRecord struct {
+	RecordID     string         // Record index ID
+	OwnerAddress sdk.AccAddress // Owner of the record
+	Description  string         // New data/file description
+}
+
+func NewMsgAmendRecord(recordID string, ownerAddress sdk.AccAddress, description string) MsgAmendRecord {
+	return MsgAmendRecord{
+		RecordID:     recordID,
+		OwnerAddress: ownerAddress,
+		Description:  description,
+	}
+}
+
+// Implements Msg.
+func (msg MsgAmendRecord) Route() string { return MsgType }
+func (msg MsgAmendRecord) Type() string  { return "amend_record" }
+
+// Implements Msg.
+func (msg MsgAmendRecord) ValidateBasic() sdk.Error {
+	if len(msg.RecordID) == 0 {
+		return ErrUnknownRecord(DefaultCodespace, msg.RecordID)
+	}
+
+	descriptionSize := int64(binary.Size([]byte(msg.Description)))
+	if descriptionSize == 0 ||
+		descriptionSize > UploadLimitOfDescription {
+		return ErrInvalidDescription(DefaultCodespace, UploadLimitOfDescription)
+	}
+
+	if len(msg.OwnerAddress) == 0 {
+		return sdk.ErrInvalidAddress(msg.OwnerAddress.String())
+	}
+
+	return nil
+}
+
+func (msg MsgAmendRecord) String() string {
+	return fmt.Sprintf("MsgAmendRecord{%s, %s}", msg.RecordID, msg.OwnerAddress)
+}
+
+// Implements Msg.
+func (msg MsgAmendRecord) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgAmendRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+//-----------------------------------------------------------
+// MsgSupersedeRecord, replaces a record with the new data, the new record is the next version of it
+type MsgSupersedeRecord struct {
+	RecordID  string          // ID of the superseded record
+	NewRecord MsgSubmitRecord // The new version of the record
+}
+
+func NewMsgSupersedeRecord(recordID string, newRecord MsgSubmitRecord) MsgSupersedeRecord {
+	return MsgSupersedeRecord{
+		RecordID:  recordID,
+		NewRecord: newRecord,
+	}
+}
+
+// Implements Msg.
+func (msg MsgSupersedeRecord) Route() string { return MsgType }
+func (msg MsgSupersedeRecord) Type() string  { return "supersede_record" }
+
+// Implements Msg.
+func (msg MsgSupersedeRecord) ValidateBasic() sdk.Error {
+	if len(msg.RecordID) == 0 {
+		return ErrUnknownRecord(DefaultCodespace, msg.RecordID)
+	}
+	if msg.RecordID == msg.NewRecord.RecordID {
+		return ErrRecordExists(DefaultCodespace, msg.RecordID)
+	}
+	return msg.NewRecord.ValidateBasic()
+}
+
+func (msg MsgSupersedeRecord) String() string {
+	return fmt.Sprintf("MsgSupersedeRecord{%s, %s}", msg.RecordID, msg.NewRecord)
+}
+
+// Implements Msg.
+func (msg MsgSupersedeRecord) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgSupersedeRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewRecord.OwnerAddress}
+}
+
+//-----------------------------------------------------------
+// MsgRevokeRecord
+type MsgRevokeRecord struct {
+	RecordID     string         // Record index ID
+	OwnerAddress sdk.AccAddress // Owner of the record
+	Reason       string         // Reason of the revocation
+}
+
+func NewMsgRevokeRecord(recordID string, ownerAddress sdk.AccAddress, reason string) MsgRevokeRecord {
+	return MsgRevokeRecord{
+		RecordID:     recordID,
+		OwnerAddress: ownerAddress,
+		Reason:       reason,
+	}
+}
+
+// Implements Msg.
+func (msg MsgRevokeRecord) Route() string { return MsgType }
+func (msg MsgRevokeRecord) Type() string  { return "revoke_record" }
+
+// Implements Msg.
+func (msg MsgRevokeRecord) ValidateBasic() sdk.Error {
+	if len(msg.RecordID) == 0 {
+		return ErrUnknownRecord(DefaultCodespace, msg.RecordID)
+	}
+
+	if int64(binary.Size([]byte(msg.Reason))) > LimitOfRevokeReason {
+		return ErrInvalidRevokeReason(DefaultCodespace, LimitOfRevokeReason)
+	}
+
+	if len(msg.OwnerAddress) == 0 {
+		return sdk.ErrInvalidAddress(msg.OwnerAddress.String())
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeRecord) String() string {
+	return fmt.Sprintf("MsgRevokeRecord{%s, %s}", msg.RecordID, msg.OwnerAddress)
+}
+
+// Implements Msg.
+func (msg MsgRevokeRecord) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgRevokeRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
@@ -75,6 +75,26 @@ func TestMsgSubmitRecord(t *testing.T) {
 			normalRecordData,
 			false,
 		},
+		{
+			time.Now().Unix(),
+			addrs[0],
+			string(KeyRecord("abc:def")),
+			normalDescription,
+			"abc:def",
+			int64(binary.Size([]byte(normalRecordData))),
+			normalRecordData,
+			false,
+		},
+		{
+			time.Now().Unix(),
+			addrs[0],
+			string(KeyRecord("abcdef")),
+			normalDescription,
+			"abcdef",
+			int64(binary.Size([]byte(normalRecordData))),
+			normalRecordData,
+			false,
+		},
 		// -------------------OwnerAddress Field-------------------------
 		{
 			time.Now().Unix(),
@@ -118,3 +138,28 @@ func TestMsgSubmitRecord(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for offchain MsgSubmitRecord
+func TestMsgSubmitOffchainRecord(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	dataHash := getDataHash(createOverflowedData("record data", UploadLimitOfOnchain))
+	dataURI := "ipfs://QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"
+
+	msg := NewMsgSubmitOffchainRecord("record description", time.Now().Unix(), addrs[0], dataHash, 2*UploadLimitOfOnchain, dataURI)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg = NewMsgSubmitOffchainRecord("record description", time.Now().Unix(), addrs[0], dataHash, 0, dataURI)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgSubmitOffchainRecord("record description", time.Now().Unix(), addrs[0], "not a hash", 100, dataURI)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgSubmitOffchainRecord("record description", time.Now().Unix(), addrs[0], dataHash, 100, createOverflowedData(dataURI, LimitOfDataURI))
+	require.NotNil(t, msg.ValidateBasic())
+
+	// offchain records can't carry onchain data
+	msg = NewMsgSubmitOffchainRecord("record description", time.Now().Unix(), addrs[0], dataHash, 100, dataURI)
+	msg.Data = "record data"
+	require.NotNil(t, msg.ValidateBasic())
+}
@@ -0,0 +1,158 @@
+package record
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the record Querier
+const (
+	QueryRecord  = "record"
+	QueryRecords = "records"
+	QueryHistory = "history"
+
+	DefaultQueryLimit = 100
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryRecord:
+			return queryRecord(ctx, path[1:], req, keeper)
+		case QueryRecords:
+			return queryRecords(ctx, path[1:], req, keeper)
+		case QueryHistory:
+			return queryHistory(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown record query endpoint")
+		}
+	}
+}
+
+// Params for query 'custom/record/record'
+type QueryRecordParams struct {
+	RecordID string
+}
+
+// nolint: unparam
+func queryRecord(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRecordParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	record, found := keeper.GetRecord(ctx, params.RecordID)
+	if !found {
+		return nil, ErrUnknownRecord(DefaultCodespace, params.RecordID)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, record)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/record/records', the records of Owner submitted within [StartTime, EndTime],
+// a zero EndTime means no upper bound
+type QueryRecordsParams struct {
+	Owner     sdk.AccAddress
+	StartTime int64
+	EndTime   int64
+	Page      uint64
+	Limit     uint64
+}
+
+// nolint: unparam
+func queryRecords(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRecordsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+	if len(params.Owner) == 0 {
+		return nil, sdk.ErrInvalidAddress("owner address can't be empty")
+	}
+	if params.EndTime > 0 && params.EndTime < params.StartTime {
+		return nil, sdk.ErrUnknownRequest("end time must not be earlier than start time")
+	}
+
+	page, limit := params.Page, params.Limit
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+	skip := (page - 1) * limit
+
+	records := []MsgSubmitRecord{}
+	iterator := keeper.OwnerRecordsIterator(ctx, params.Owner, params.StartTime, params.EndTime)
+	defer iterator.Close()
+	for ; iterator.Valid() && uint64(len(records)) < limit; iterator.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		record, found := keeper.GetRecord(ctx, string(iterator.Value()))
+		if !found {
+			panic("record index points to a missing record")
+		}
+		records = append(records, record)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// RecordHistory - the state, the version chain and the audit events of a record
+type RecordHistory struct {
+	State    RecordState   `json:"state"`
+	Versions []string      `json:"versions"` // record IDs of the version chain, from the first version
+	Events   []RecordEvent `json:"events"`
+}
+
+// nolint: unparam
+func queryHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRecordParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	state, found := keeper.GetRecordState(ctx, params.RecordID)
+	if !found {
+		return nil, ErrUnknownRecord(DefaultCodespace, params.RecordID)
+	}
+
+	// walk back to the first version, then forward to the latest one
+	first := state
+	for len(first.PrevRecordID) > 0 {
+		first, _ = keeper.GetRecordState(ctx, first.PrevRecordID)
+	}
+	versions := []string{first.RecordID}
+	for version := first; len(version.NextRecordID) > 0; {
+		version, _ = keeper.GetRecordState(ctx, version.NextRecordID)
+		versions = append(versions, version.RecordID)
+	}
+
+	events := []RecordEvent{}
+	iterator := keeper.GetRecordEvents(ctx, params.RecordID)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var event RecordEvent
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &event)
+		events = append(events, event)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, RecordHistory{State: state, Versions: versions, Events: events})
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
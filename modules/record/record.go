@@ -0,0 +1,119 @@
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/pkg/errors"
+)
+
+// RecordState - the lifecycle of a record, the submitted record itself is kept unchanged except its description
+type RecordState struct {
+	RecordID     string         `json:"record_id"`
+	Owner        sdk.AccAddress `json:"owner"`
+	Status       RecordStatus   `json:"status"`
+	Version      uint64         `json:"version"`        // position in the version chain, starting from 1
+	PrevRecordID string         `json:"prev_record_id"` // the record superseded by this one
+	NextRecordID string         `json:"next_record_id"` // the record superseding this one
+	SubmitHeight int64          `json:"submit_height"`
+	UpdateHeight int64          `json:"update_height"`
+	Revision     uint64         `json:"revision"` // number of history events of the record
+}
+
+func NewRecordState(recordID string, owner sdk.AccAddress, version uint64, prevRecordID string, height int64) RecordState {
+	return RecordState{
+		RecordID:     recordID,
+		Owner:        owner,
+		Status:       StatusActive,
+		Version:      version,
+		PrevRecordID: prevRecordID,
+		SubmitHeight: height,
+		UpdateHeight: height,
+	}
+}
+
+// RecordEvent - an entry of the audit history of a record
+type RecordEvent struct {
+	Action         string         `json:"action"`
+	Height         int64          `json:"height"`
+	Owner          sdk.AccAddress `json:"owner"`
+	OldDescription string         `json:"old_description"` // amend only
+	NewDescription string         `json:"new_description"` // amend only
+	NewRecordID    string         `json:"new_record_id"`   // supersede only
+	Reason         string         `json:"reason"`          // revoke only
+}
+
+const (
+	EventSubmit    = "submit"
+	EventAmend     = "amend"
+	EventSupersede = "supersede"
+	EventRevoke    = "revoke"
+)
+
+type RecordStatus byte
+
+const (
+	StatusActive     RecordStatus = 0x01
+	StatusSuperseded RecordStatus = 0x02
+	StatusRevoked    RecordStatus = 0x03
+)
+
+// String to RecordStatus byte, Returns ff if invalid.
+func RecordStatusFromString(str string) (RecordStatus, error) {
+	switch str {
+	case "Active":
+		return StatusActive, nil
+	case "Superseded":
+		return StatusSuperseded, nil
+	case "Revoked":
+		return StatusRevoked, nil
+	default:
+		return RecordStatus(0xff), errors.Errorf("'%s' is not a valid record status", str)
+	}
+}
+
+// For Printf / Sprintf, returns bech32 when using %s
+func (status RecordStatus) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", status.String())))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(status))))
+	}
+}
+
+// Turns RecordStatus byte to String
+func (status RecordStatus) String() string {
+	switch status {
+	case StatusActive:
+		return "Active"
+	case StatusSuperseded:
+		return "Superseded"
+	case StatusRevoked:
+		return "Revoked"
+	default:
+		return ""
+	}
+}
+
+// Marshals to JSON using string
+func (status RecordStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// Unmarshals from JSON
+func (status *RecordStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := RecordStatusFromString(s)
+	if err != nil {
+		return err
+	}
+	*status = bz2
+	return nil
+}
@@ -6,9 +6,13 @@ import (
 )
 
 var (
-	ActionSubmitRecord = []byte("submit-record")
+	ActionSubmitRecord    = []byte("submit-record")
+	ActionAmendRecord     = []byte("amend-record")
+	ActionSupersedeRecord = []byte("supersede-record")
+	ActionRevokeRecord    = []byte("revoke-record")
 
 	Action       = sdk.TagAction
 	OwnerAddress = "ownerAddress"
 	RecordID     = "record-id"
+	NewRecordID  = "new-record-id"
 )
@@ -7,6 +7,9 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitRecord{}, "iris-hub/record/MsgSubmitRecord", nil)
+	cdc.RegisterConcrete(MsgAmendRecord{}, "iris-hub/record/MsgAmendRecord", nil)
+	cdc.RegisterConcrete(MsgSupersedeRecord{}, "iris-hub/record/MsgSupersedeRecord", nil)
+	cdc.RegisterConcrete(MsgRevokeRecord{}, "iris-hub/record/MsgRevokeRecord", nil)
 }
 
 var msgCdc = codec.New()
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/sha256"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// The output of a PubKeyEncryption method is encrypted to the consumer's secp256k1 public key with ECIES,
+// the envelope is the one of btcec: IV | ephemeral public key | AES-256-CBC ciphertext | HMAC-SHA256
+const (
+	ephemeralPubKeyLength = 70
+	encryptedOverhead     = aes.BlockSize + ephemeralPubKeyLength + sha256.Size
+)
+
+var (
+	eciesCurveBytes  = []byte{0x02, 0xCA}
+	eciesCoordLength = []byte{0x00, 0x20}
+)
+
+// Encrypt the service output to the consumer's public key
+func EncryptOutput(pubKey crypto.PubKey, output []byte) ([]byte, error) {
+	secpPubKey, ok := pubKey.(secp256k1.PubKeySecp256k1)
+	if !ok {
+		return nil, errors.New("output encryption requires a secp256k1 public key")
+	}
+	ecPubKey, err := btcec.ParsePubKey(secpPubKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return btcec.Encrypt(ecPubKey, output)
+}
+
+// Decrypt the service output with the consumer's private key
+func DecryptOutput(privKey crypto.PrivKey, output []byte) ([]byte, error) {
+	secpPrivKey, ok := privKey.(secp256k1.PrivKeySecp256k1)
+	if !ok {
+		return nil, errors.New("output decryption requires a secp256k1 private key")
+	}
+	ecPrivKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), secpPrivKey[:])
+	return btcec.Decrypt(ecPrivKey, output)
+}
+
+// is the output a well formed ECIES envelope with a valid ephemeral public key?
+// Only the format is checked: the chain can't tell whose public key the output is encrypted to.
+func validEncryptedOutput(output []byte) bool {
+	if len(output) <= encryptedOverhead || (len(output)-encryptedOverhead)%aes.BlockSize != 0 {
+		return false
+	}
+
+	pubKey := output[aes.BlockSize : aes.BlockSize+ephemeralPubKeyLength]
+	if !bytes.Equal(pubKey[0:2], eciesCurveBytes) ||
+		!bytes.Equal(pubKey[2:4], eciesCoordLength) ||
+		!bytes.Equal(pubKey[36:38], eciesCoordLength) {
+		return false
+	}
+
+	// uncompressed form of the ephemeral public key
+	uncompressed := append([]byte{0x04}, pubKey[4:36]...)
+	uncompressed = append(uncompressed, pubKey[38:70]...)
+	_, err := btcec.ParsePubKey(uncompressed, btcec.S256())
+	return err == nil
+}
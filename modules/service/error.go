@@ -42,6 +42,15 @@ const (
 	CodeNotMatchingReqChainID  sdk.CodeType = 129
 
 	CodeIntOverflow sdk.CodeType = 130
+
+	CodeInsufficientDeposit    sdk.CodeType = 131
+	CodeNoAvailableBinding     sdk.CodeType = 132
+	CodeDuplicateProvider      sdk.CodeType = 133
+	CodeInvalidEncryptedOutput sdk.CodeType = 134
+	CodeInvalidCachedOutput    sdk.CodeType = 135
+	CodeNotOffChainCached      sdk.CodeType = 136
+	CodePrivateCachedOutput    sdk.CodeType = 137
+	CodeNotProfiler            sdk.CodeType = 138
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -184,3 +193,37 @@ func ErrInvalidBindChainId(codespace sdk.CodespaceType) sdk.Error {
 func ErrNotMatchingReqChainID(codespace sdk.CodespaceType, reqChainID string) sdk.Error {
 	return sdk.NewError(codespace, CodeNotMatchingReqChainID, fmt.Sprintf("[%s] is not a matching reqChainID", reqChainID))
 }
+
+func ErrInsufficientDeposit(codespace sdk.CodespaceType, deposit, coins sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientDeposit, fmt.Sprintf("service binding deposit %s is less than %s", deposit.String(), coins.String()))
+}
+
+func ErrNoAvailableBinding(codespace sdk.CodespaceType, defChainId, svcDefName string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAvailableBinding, fmt.Sprintf("there is no available binding of service %s in %s", svcDefName, defChainId))
+}
+
+func ErrDuplicateProvider(codespace sdk.CodespaceType, provider sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateProvider, fmt.Sprintf("provider [%s] is duplicated in the multicast request", provider.String()))
+}
+
+// ErrInvalidEncryptedOutput is returned when the output is not a well formed ECIES envelope,
+// the recipient of the envelope is not checked
+func ErrInvalidEncryptedOutput(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEncryptedOutput, fmt.Sprintf("output of a PubKeyEncryption method must be a well formed ECIES envelope"))
+}
+
+func ErrInvalidCachedOutput(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCachedOutput, fmt.Sprintf("cached output needs a sha256 output hash and a location no longer than %d, without output", MaxOutputLocationLength))
+}
+
+func ErrNotOffChainCached(codespace sdk.CodespaceType, methodID int16) sdk.Error {
+	return sdk.NewError(codespace, CodeNotOffChainCached, fmt.Sprintf("output of method %d is not OffChainCached", methodID))
+}
+
+func ErrPrivateCachedOutput(codespace sdk.CodespaceType, methodID int16) sdk.Error {
+	return sdk.NewError(codespace, CodePrivateCachedOutput, fmt.Sprintf("output of method %d is PubKeyEncryption, it must be encrypted on chain instead of cached off-chain", methodID))
+}
+
+func ErrNotProfiler(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotProfiler, fmt.Sprintf("[%s] is not a profiler, only profilers can send profiling requests", address))
+}
@@ -0,0 +1,10 @@
+package service
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// expected distribution keeper
+type DistributionKeeper interface {
+	AddCommunityPoolCoins(ctx sdk.Context, coins sdk.Coins)
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/service/params"
 	"github.com/irisnet/irishub/modules/params"
@@ -10,29 +12,49 @@ import (
 type GenesisState struct {
 	MaxRequestTimeout  int64
 	MinDepositMultiple int64
+	SlashFraction      sdk.Dec `json:"slash_fraction"`
 }
 
-func NewGenesisState(maxRequestTimeout int64, minDepositMultiple int64) GenesisState {
+func NewGenesisState(maxRequestTimeout int64, minDepositMultiple int64, slashFraction sdk.Dec) GenesisState {
 	return GenesisState{
 		MaxRequestTimeout:  maxRequestTimeout,
 		MinDepositMultiple: minDepositMultiple,
+		SlashFraction:      slashFraction,
 	}
 }
 
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, data GenesisState) {
+	// genesis files created before the slashing of expired requests have no slash fraction
+	if data.SlashFraction.IsNil() {
+		data.SlashFraction = DefaultGenesisState().SlashFraction
+	}
 	params.InitGenesisParameter(&serviceparams.MaxRequestTimeoutParameter, ctx, data.MaxRequestTimeout)
 	params.InitGenesisParameter(&serviceparams.MinDepositMultipleParameter, ctx, data.MinDepositMultiple)
+	params.InitGenesisParameter(&serviceparams.SlashFractionParameter, ctx, data.SlashFraction)
+}
+
+// ValidateGenesis checks the slash fraction, a missing one falls back to the default
+func ValidateGenesis(data GenesisState) error {
+	if data.SlashFraction.IsNil() {
+		return nil
+	}
+	if data.SlashFraction.LT(sdk.ZeroDec()) || data.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("service slash fraction should be between 0 and 1, is %s", data.SlashFraction.String())
+	}
+	return nil
 }
 
 // ExportGenesis - output genesis parameters
 func ExportGenesis(ctx sdk.Context) GenesisState {
 	maxRequestTimeout := serviceparams.GetMaxRequestTimeout(ctx)
 	minDepositMultiple := serviceparams.GetMinDepositMultiple(ctx)
+	slashFraction := serviceparams.GetSlashFraction(ctx)
 
 	return GenesisState{
 		MaxRequestTimeout:  maxRequestTimeout,
 		MinDepositMultiple: minDepositMultiple,
+		SlashFraction:      slashFraction,
 	}
 }
 
@@ -41,6 +63,7 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		MaxRequestTimeout:  100,
 		MinDepositMultiple: 1000,
+		SlashFraction:      sdk.NewDecWithPrec(1, 3),
 	}
 }
 
@@ -49,5 +72,6 @@ func DefaultGenesisStateForTest() GenesisState {
 	return GenesisState{
 		MaxRequestTimeout:  10,
 		MinDepositMultiple: 10,
+		SlashFraction:      sdk.NewDecWithPrec(1, 2),
 	}
 }
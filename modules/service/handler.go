@@ -24,6 +24,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSvcRefundDeposit(ctx, k, msg)
 		case MsgSvcRequest:
 			return handleMsgSvcRequest(ctx, k, msg)
+		case MsgSvcMulticastRequest:
+			return handleMsgSvcMulticastRequest(ctx, k, msg)
 		case MsgSvcResponse:
 			return handleMsgSvcResponse(ctx, k, msg)
 		case MsgSvcRefundFees:
@@ -136,15 +138,20 @@ func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Resul
 		return ErrMethodNotExists(k.Codespace(), msg.MethodID).Result()
 	}
 
+	// profiling requests are free of service charges, but only profilers can send them
+	if msg.Profiling && !k.IsProfiler(ctx, msg.Consumer) {
+		return ErrNotProfiler(k.Codespace(), msg.Consumer).Result()
+	}
+
 	//Method id start at 1
-	if len(bind.Prices) >= int(msg.MethodID) && !msg.ServiceFee.IsAllGTE(sdk.Coins{bind.Prices[msg.MethodID-1]}) {
+	if !msg.Profiling && len(bind.Prices) >= int(msg.MethodID) && !msg.ServiceFee.IsAllGTE(sdk.Coins{bind.Prices[msg.MethodID-1]}) {
 		return ErrLtServiceFee(k.Codespace(), sdk.Coins{bind.Prices[msg.MethodID-1]}).Result()
 	}
 
 	request := NewSvcRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.Provider, msg.MethodID, msg.Input, msg.ServiceFee, msg.Profiling)
 
 	// request service fee is equal to service binding service fee
-	if len(bind.Prices) >= int(msg.MethodID) {
+	if !msg.Profiling && len(bind.Prices) >= int(msg.MethodID) {
 		request.ServiceFee = sdk.Coins{bind.Prices[msg.MethodID-1]}
 	} else {
 		request.ServiceFee = nil
@@ -154,12 +161,107 @@ func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Resul
 	if err != nil {
 		return err.Result()
 	}
+
+	action := tags.ActionSvcCall
+	if request.Profiling {
+		action = tags.ActionSvcProfilingCall
+		k.AddProfilingRequest(ctx, request)
+	}
 	resTags := sdk.NewTags(
-		tags.Action, tags.ActionSvcCall,
+		tags.Action, action,
 		tags.RequestID, []byte(request.RequestID()),
 		tags.Provider, []byte(request.Provider.String()),
 		tags.Consumer, []byte(request.Consumer.String()),
 	)
+	if request.Profiling {
+		resTags = resTags.AppendTag(tags.Profiling, []byte("true"))
+	}
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgSvcMulticastRequest(ctx sdk.Context, k Keeper, msg MsgSvcMulticastRequest) sdk.Result {
+	_, methodFound := k.GetMethod(ctx, msg.DefChainID, msg.DefName, msg.MethodID)
+	if !methodFound {
+		return ErrMethodNotExists(k.Codespace(), msg.MethodID).Result()
+	}
+
+	if msg.Profiling && !k.IsProfiler(ctx, msg.Consumer) {
+		return ErrNotProfiler(k.Codespace(), msg.Consumer).Result()
+	}
+
+	var bindings []SvcBinding
+	if len(msg.Providers) > 0 {
+		for _, provider := range msg.Providers {
+			bind, bindingFound := k.GetServiceBinding(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, provider)
+			if !bindingFound {
+				return ErrSvcBindingNotExists(k.Codespace()).Result()
+			}
+			if !bind.Available {
+				return ErrSvcBindingNotAvailable(k.Codespace()).Result()
+			}
+			bindings = append(bindings, bind)
+		}
+	} else {
+		bindingIterator := k.GetServiceBindings(ctx, msg.DefChainID, msg.DefName)
+		for ; bindingIterator.Valid(); bindingIterator.Next() {
+			var bind SvcBinding
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bindingIterator.Value(), &bind)
+			if bind.Available && bind.BindChainID == msg.BindChainID {
+				bindings = append(bindings, bind)
+			}
+		}
+		bindingIterator.Close()
+	}
+	if len(bindings) == 0 {
+		return ErrNoAvailableBinding(k.Codespace(), msg.DefChainID, msg.DefName).Result()
+	}
+
+	group := NewRequestGroup(GetGroupID(ctx.BlockHeight(), k.GetIntraTxCounter(ctx)),
+		msg.DefChainID, msg.DefName, msg.ReqChainID, msg.MethodID, msg.Consumer)
+	action := tags.ActionSvcMulticastCall
+	if msg.Profiling {
+		action = tags.ActionSvcProfilingMulticastCall
+	}
+	resTags := sdk.NewTags(
+		tags.Action, action,
+		tags.GroupID, []byte(group.GroupID),
+		tags.Consumer, []byte(msg.Consumer.String()),
+	)
+	if msg.Profiling {
+		resTags = resTags.AppendTag(tags.Profiling, []byte("true"))
+	}
+
+	for _, bind := range bindings {
+		//Method id start at 1
+		if !msg.Profiling && len(bind.Prices) >= int(msg.MethodID) && !msg.ServiceFee.IsAllGTE(sdk.Coins{bind.Prices[msg.MethodID-1]}) {
+			return ErrLtServiceFee(k.Codespace(), sdk.Coins{bind.Prices[msg.MethodID-1]}).Result()
+		}
+
+		request := NewSvcRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, bind.Provider, msg.MethodID, msg.Input, nil, msg.Profiling)
+		request.GroupID = group.GroupID
+
+		// request service fee is equal to service binding service fee, profiling requests are free
+		if !msg.Profiling && len(bind.Prices) >= int(msg.MethodID) {
+			request.ServiceFee = sdk.Coins{bind.Prices[msg.MethodID-1]}
+		}
+
+		request, err := k.AddRequest(ctx, request)
+		if err != nil {
+			return err.Result()
+		}
+		if request.Profiling {
+			k.AddProfilingRequest(ctx, request)
+		}
+
+		group.Providers = append(group.Providers, request.Provider)
+		group.RequestIDs = append(group.RequestIDs, request.RequestID())
+		resTags = resTags.AppendTag(tags.RequestID, []byte(request.RequestID()))
+		resTags = resTags.AppendTag(tags.Provider, []byte(request.Provider.String()))
+	}
+
+	k.SetRequestGroup(ctx, group)
 	return sdk.Result{
 		Tags: resTags,
 	}
@@ -180,21 +282,48 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 	if request.ReqChainID != msg.ReqChainID {
 		return ErrNotMatchingReqChainID(k.Codespace(), msg.ReqChainID).Result()
 	}
+	method, found := k.GetMethod(ctx, request.DefChainID, request.DefName, request.MethodID)
+	if len(msg.OutputHash) > 0 {
+		// only the output of OffChainCached methods can be kept off-chain
+		if !found || method.OutputCached != OffChainCached {
+			return ErrNotOffChainCached(k.Codespace(), request.MethodID).Result()
+		}
+		// the location of a cached output is public, a private output can't be cached
+		if method.OutputPrivacy == PubKeyEncryption {
+			return ErrPrivateCachedOutput(k.Codespace(), request.MethodID).Result()
+		}
+	} else if found && method.OutputPrivacy == PubKeyEncryption && len(msg.Output) > 0 && !validEncryptedOutput(msg.Output) {
+		return ErrInvalidEncryptedOutput(k.Codespace()).Result()
+	}
 
 	response := NewSvcResponse(msg.ReqChainID, eHeight, rHeight, counter, msg.Provider,
 		request.Consumer, msg.Output, msg.ErrorMsg)
+	response.ResponseTime = ctx.BlockHeader().Time
+	response.OutputHash = msg.OutputHash
+	response.OutputLocation = msg.OutputLocation
 
 	k.AddResponse(ctx, response)
+	if len(request.GroupID) > 0 {
+		k.AddGroupResponse(ctx, request.GroupID, response)
+	}
 
 	// delete request from active request list and expiration list
 	k.DeleteActiveRequest(ctx, request)
 	k.DeleteRequestExpiration(ctx, request)
 
-	k.AddIncomingFee(ctx, response.Provider, request.ServiceFee)
+	// profiling requests are free, the provider earns nothing from them
+	if request.Profiling {
+		k.AddProfilingResponse(ctx, request, response)
+	} else {
+		k.AddIncomingFee(ctx, response.Provider, request.ServiceFee)
+	}
 
 	resTags := sdk.NewTags(
 		tags.Action, tags.ActionSvcRespond,
 	)
+	if request.Profiling {
+		resTags = resTags.AppendTag(tags.Profiling, []byte("true"))
+	}
 	return sdk.Result{
 		Tags: resTags,
 	}
@@ -240,14 +369,41 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		var req SvcRequest
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &req)
 
+		// a profiling request escrows no service fee, its timeout is counted in the profiling stats
 		keeper.AddReturnFee(ctx, req.Consumer, req.ServiceFee)
+		if req.Profiling {
+			keeper.AddProfilingTimeout(ctx, req)
+		}
 
 		keeper.DeleteActiveRequest(ctx, req)
 		keeper.DeleteRequestExpiration(ctx, req)
 
 		resTags = resTags.AppendTag(tags.Action, tags.ActionSvcCallTimeOut)
+		if len(req.GroupID) > 0 {
+			resTags = resTags.AppendTag(tags.GroupID, []byte(req.GroupID))
+		}
 		logger.Info(fmt.Sprintf("request %s from %s timeout",
 			req.RequestID(), req.Consumer))
+
+		// the provider is not slashed for a profiling timeout
+		if req.Profiling {
+			continue
+		}
+
+		slashCoins, disabled := keeper.Slash(ctx, req)
+		if !slashCoins.IsZero() {
+			resTags = resTags.AppendTag(tags.Action, tags.ActionSvcSlash)
+			resTags = resTags.AppendTag(tags.Provider, []byte(req.Provider.String()))
+			resTags = resTags.AppendTag(tags.Slashed, []byte(slashCoins.String()))
+			logger.Info(fmt.Sprintf("provider %s is slashed %s for request %s",
+				req.Provider, slashCoins.String(), req.RequestID()))
+		}
+		if disabled {
+			resTags = resTags.AppendTag(tags.Action, tags.ActionSvcDisable)
+			resTags = resTags.AppendTag(tags.Provider, []byte(req.Provider.String()))
+			logger.Info(fmt.Sprintf("service binding of provider %s is disabled for insufficient deposit",
+				req.Provider))
+		}
 	}
 	activeIterator.Close()
 
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"errors"
 	"strconv"
+	"time"
+	"crypto/sha256"
 )
 
 type SvcRequest struct {
@@ -22,6 +24,7 @@ type SvcRequest struct {
 	RequestHeight         int64          `json:"request_height"`           // block height of service request
 	RequestIntraTxCounter int16          `json:"request_intra_tx_counter"` // block-local tx index of service request
 	ExpirationHeight      int64          `json:"expiration_height"`        // block height of the service request has expired
+	GroupID               string         `json:"group_id"`                 // request group of a multicast request, empty for unicast
 }
 
 func NewSvcRequest(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) SvcRequest {
@@ -64,6 +67,34 @@ func ConvertRequestID(requestId string) (eHeight int64, rHeight int64, counter i
 	return eHeight, rHeight, int16(counterInt), err
 }
 
+// RequestGroup collects the requests fanned out by a multicast request
+type RequestGroup struct {
+	GroupID    string           `json:"group_id"`
+	DefChainID string           `json:"def_chain_id"`
+	DefName    string           `json:"def_name"`
+	ReqChainID string           `json:"req_chain_id"`
+	MethodID   int16            `json:"method_id"`
+	Consumer   sdk.AccAddress   `json:"consumer"`
+	Providers  []sdk.AccAddress `json:"providers"`
+	RequestIDs []string         `json:"request_ids"`
+}
+
+func NewRequestGroup(groupID, defChainID, defName, reqChainID string, methodID int16, consumer sdk.AccAddress) RequestGroup {
+	return RequestGroup{
+		GroupID:    groupID,
+		DefChainID: defChainID,
+		DefName:    defName,
+		ReqChainID: reqChainID,
+		MethodID:   methodID,
+		Consumer:   consumer,
+	}
+}
+
+// GroupID is of format requestHeight-intraTxCounter of the first request in the group
+func GetGroupID(height int64, counter int16) string {
+	return fmt.Sprintf("%d-%d", height, counter)
+}
+
 type SvcResponse struct {
 	ReqChainID            string         `json:"req_chain_id"`
 	RequestHeight         int64          `json:"request_height"`
@@ -73,6 +104,9 @@ type SvcResponse struct {
 	Consumer              sdk.AccAddress `json:"consumer"`
 	Output                []byte         `json:"output"`
 	ErrorMsg              []byte         `json:"error_msg"`
+	ResponseTime          time.Time      `json:"response_time"` // block time of service response
+	OutputHash            []byte         `json:"output_hash"`
+	OutputLocation        string         `json:"output_location"`
 }
 
 func NewSvcResponse(reqChainID string, eheight int64, rheight int64, counter int16, provider, consumer sdk.AccAddress, out []byte, errorMsg []byte) SvcResponse {
@@ -88,6 +122,12 @@ func NewSvcResponse(reqChainID string, eheight int64, rheight int64, counter int
 	}
 }
 
+// hash of an off-chain cached output
+func GetOutputHash(output []byte) []byte {
+	hash := sha256.Sum256(output)
+	return hash[:]
+}
+
 // return fee of a consumer
 type ReturnedFee struct {
 	Address sdk.AccAddress `json:"address"`
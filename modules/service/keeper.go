@@ -5,6 +5,7 @@ import (
 	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/tools/protoidl"
 	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/guardian"
 	"fmt"
 	"github.com/irisnet/irishub/modules/service/params"
 	"github.com/irisnet/irishub/modules/arbitration/params"
@@ -15,16 +16,20 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	cdc      *codec.Codec
 	ck       bank.Keeper
+	dk       DistributionKeeper
+	gk       guardian.Keeper
 
 	// codespace
 	codespace sdk.CodespaceType
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ck bank.Keeper, codespace sdk.CodespaceType) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ck bank.Keeper, dk DistributionKeeper, gk guardian.Keeper, codespace sdk.CodespaceType) Keeper {
 	keeper := Keeper{
 		storeKey:  key,
 		cdc:       cdc,
 		ck:        ck,
+		dk:        dk,
+		gk:        gk,
 		codespace: codespace,
 	}
 	return keeper
@@ -85,6 +90,15 @@ func (k Keeper) GetMethod(ctx sdk.Context, chainId, name string, id int16) (meth
 	return method, false
 }
 
+// Gets all the service definitions, or the ones of a chain if chainId is not empty
+func (k Keeper) GetServiceDefinitions(ctx sdk.Context, chainId string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	if len(chainId) == 0 {
+		return sdk.KVStorePrefixIterator(store, serviceDefinitionKey)
+	}
+	return sdk.KVStorePrefixIterator(store, GetDefinitionsSubspaceKey(chainId))
+}
+
 // Gets all the methods in a specific service
 func (k Keeper) GetMethods(ctx sdk.Context, chainId, name string) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -141,6 +155,18 @@ func (k Keeper) GetServiceBinding(ctx sdk.Context, defChainID, defName, bindChai
 	return svcBinding, false
 }
 
+// Gets all the bindings of a service definition
+func (k Keeper) GetServiceBindings(ctx sdk.Context, defChainID, defName string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetBindingsSubspaceKey(defChainID, defName))
+}
+
+// Gets all the service bindings of all service definitions
+func (k Keeper) GetAllServiceBindings(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, bindingPropertyKey)
+}
+
 func (k Keeper) UpdateServiceBinding(ctx sdk.Context, svcBinding SvcBinding) (sdk.Error, bool) {
 	kvStore := ctx.KVStore(k.storeKey)
 	oldBinding, found := k.GetServiceBinding(ctx, svcBinding.DefChainID, svcBinding.DefName, svcBinding.BindChainID, svcBinding.Provider)
@@ -284,6 +310,74 @@ func (k Keeper) RefundDeposit(ctx sdk.Context, defChainID, defName, bindChainID
 	return nil, true
 }
 
+// Lock part of a binding deposit, the locked coins are held outside the binding until released
+func (k Keeper) LockDeposit(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	kvStore := ctx.KVStore(k.storeKey)
+	binding, found := k.GetServiceBinding(ctx, defChainID, defName, bindChainID, provider)
+	if !found {
+		return ErrSvcBindingNotExists(k.Codespace())
+	}
+
+	if !binding.Deposit.IsAllGTE(coins) {
+		return ErrInsufficientDeposit(k.Codespace(), binding.Deposit, coins)
+	}
+	binding.Deposit = binding.Deposit.Minus(coins)
+
+	svcBindingBytes := k.cdc.MustMarshalBinaryLengthPrefixed(binding)
+	kvStore.Set(GetServiceBindingKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider), svcBindingBytes)
+	return nil
+}
+
+// Release the locked coins back to the binding deposit
+func (k Keeper) ReleaseDeposit(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	kvStore := ctx.KVStore(k.storeKey)
+	binding, found := k.GetServiceBinding(ctx, defChainID, defName, bindChainID, provider)
+	if !found {
+		return ErrSvcBindingNotExists(k.Codespace())
+	}
+
+	binding.Deposit = binding.Deposit.Plus(coins)
+
+	svcBindingBytes := k.cdc.MustMarshalBinaryLengthPrefixed(binding)
+	kvStore.Set(GetServiceBindingKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider), svcBindingBytes)
+	return nil
+}
+
+// Slash the binding deposit of a provider who failed to respond a request in time, the slashed coins go to
+// the community pool and the binding is disabled once its deposit drops below the minimum deposit
+func (k Keeper) Slash(ctx sdk.Context, req SvcRequest) (slashCoins sdk.Coins, disabled bool) {
+	kvStore := ctx.KVStore(k.storeKey)
+	binding, found := k.GetServiceBinding(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	if !found {
+		return slashCoins, false
+	}
+
+	slashFraction := serviceparams.GetSlashFraction(ctx)
+	for _, coin := range binding.Deposit {
+		slashAmount := sdk.NewDecFromInt(coin.Amount).Mul(slashFraction).TruncateInt()
+		if slashAmount.Sign() > 0 {
+			slashCoins = slashCoins.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, slashAmount)})
+		}
+	}
+	if !slashCoins.IsZero() {
+		binding.Deposit = binding.Deposit.Minus(slashCoins)
+		k.dk.AddCommunityPoolCoins(ctx, slashCoins)
+	}
+
+	if binding.Available {
+		minDeposit, err := getMinDeposit(ctx, binding.Prices)
+		if err != nil || !binding.Deposit.IsAllGTE(minDeposit) {
+			binding.Available = false
+			binding.DisableTime = ctx.BlockHeader().Time
+			disabled = true
+		}
+	}
+
+	svcBindingBytes := k.cdc.MustMarshalBinaryLengthPrefixed(binding)
+	kvStore.Set(GetServiceBindingKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider), svcBindingBytes)
+	return slashCoins, disabled
+}
+
 func (k Keeper) validateMethodPrices(ctx sdk.Context, svcBinding SvcBinding) sdk.Error {
 	methodIterator := k.GetMethods(ctx, svcBinding.DefChainID, svcBinding.DefName)
 	var methods []MethodProperty
@@ -301,6 +395,12 @@ func (k Keeper) validateMethodPrices(ctx sdk.Context, svcBinding SvcBinding) sdk
 
 //__________________________________________________________________________
 
+// only the profilers of the guardian module can send profiling requests
+func (k Keeper) IsProfiler(ctx sdk.Context, address sdk.AccAddress) bool {
+	_, found := k.gk.GetProfiler(ctx, address)
+	return found
+}
+
 func (k Keeper) AddRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Error) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -326,6 +426,16 @@ func (k Keeper) AddRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Err
 	return req, nil
 }
 
+func (k Keeper) GetRequest(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress, height int64, counter int16) (req SvcRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetRequestKey(defChainID, defName, bindChainID, provider, height, counter))
+	if value == nil {
+		return req, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &req)
+	return req, true
+}
+
 func (k Keeper) AddActiveRequest(ctx sdk.Context, req SvcRequest) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(req)
@@ -368,12 +478,65 @@ func (k Keeper) ActiveRequestQueueIterator(ctx sdk.Context, height int64) sdk.It
 
 //__________________________________________________________________________
 
+// Returns an iterator for all the requests in the Active Queue
+func (k Keeper) ActiveAllRequestQueueIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, requestsByExpirationIndexKey)
+}
+
 func (k Keeper) AddResponse(ctx sdk.Context, resp SvcResponse) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(resp)
 	store.Set(GetResponseKey(resp.ReqChainID, resp.ExpirationHeight, resp.RequestHeight, resp.RequestIntraTxCounter), bz)
 }
 
+func (k Keeper) GetResponse(ctx sdk.Context, reqChainID string, eHeight, rHeight int64, counter int16) (resp SvcResponse, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetResponseKey(reqChainID, eHeight, rHeight, counter))
+	if value == nil {
+		return resp, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &resp)
+	return resp, true
+}
+
+// Gets all the responses of the requests initiated in a chain
+func (k Keeper) GetResponses(ctx sdk.Context, reqChainID string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetResponsesSubspaceKey(reqChainID))
+}
+
+//__________________________________________________________________________
+
+func (k Keeper) SetRequestGroup(ctx sdk.Context, group RequestGroup) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(group)
+	store.Set(GetRequestGroupKey(group.GroupID), bz)
+}
+
+func (k Keeper) GetRequestGroup(ctx sdk.Context, groupID string) (group RequestGroup, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetRequestGroupKey(groupID))
+	if value == nil {
+		return group, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &group)
+	return group, true
+}
+
+// Add a provider's response to the request group it belongs to
+func (k Keeper) AddGroupResponse(ctx sdk.Context, groupID string, resp SvcResponse) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(resp)
+	store.Set(GetGroupResponseKey(groupID, resp.Provider), bz)
+}
+
+// Gets all the responses collected by a request group
+func (k Keeper) GetGroupResponses(ctx sdk.Context, groupID string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetGroupResponsesSubspaceKey(groupID))
+}
+
 //__________________________________________________________________________
 
 func (k Keeper) SetReturnFee(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
@@ -493,3 +656,53 @@ func getMinDeposit(ctx sdk.Context, prices []sdk.Coin) (sdk.Coins, sdk.Error) {
 	}
 	return minDeposit, nil
 }
+
+//__________________________________________________________________________
+
+func (k Keeper) GetProfilingStats(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) ProfilingStats {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetProfilingStatsKey(defChainID, defName, bindChainID, provider))
+	if value == nil {
+		return NewProfilingStats(defChainID, defName, bindChainID, provider)
+	}
+	var stats ProfilingStats
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &stats)
+	return stats
+}
+
+func (k Keeper) SetProfilingStats(ctx sdk.Context, stats ProfilingStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(stats)
+	store.Set(GetProfilingStatsKey(stats.DefChainID, stats.DefName, stats.BindChainID, stats.Provider), bz)
+}
+
+// Gets the profiling stats of all bindings of a service
+func (k Keeper) GetAllProfilingStats(ctx sdk.Context, defChainID, defName string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetProfilingStatsSubspaceKey(defChainID, defName))
+}
+
+// count a profiling request of the binding
+func (k Keeper) AddProfilingRequest(ctx sdk.Context, req SvcRequest) {
+	stats := k.GetProfilingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	stats.Requests++
+	k.SetProfilingStats(ctx, stats)
+}
+
+// count the response of a profiling request, the latency is the blocks since the request
+func (k Keeper) AddProfilingResponse(ctx sdk.Context, req SvcRequest, resp SvcResponse) {
+	stats := k.GetProfilingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	stats.Responses++
+	if len(resp.ErrorMsg) > 0 {
+		stats.Errors++
+	}
+	stats.TotalLatency += ctx.BlockHeight() - req.RequestHeight
+	k.SetProfilingStats(ctx, stats)
+}
+
+// count a profiling request expired without response
+func (k Keeper) AddProfilingTimeout(ctx sdk.Context, req SvcRequest) {
+	stats := k.GetProfilingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	stats.Timeouts++
+	k.SetProfilingStats(ctx, stats)
+}
@@ -20,12 +20,20 @@ var (
 	activeRequestKey             = []byte{0x09} // key for active request
 	returnedFeeKey               = []byte{0x10}
 	incomingFeeKey               = []byte{0x11}
+	requestGroupKey              = []byte{0x12}
+	groupResponseKey             = []byte{0x13}
+	profilingStatsKey            = []byte{0x14}
 )
 
 func GetServiceDefinitionKey(chainId, name string) []byte {
 	return append(serviceDefinitionKey, getStringsKey([]string{chainId, name})...)
 }
 
+// Key for getting all service definitions of a chain from the store
+func GetDefinitionsSubspaceKey(chainId string) []byte {
+	return append(append(serviceDefinitionKey, []byte(chainId)...), emptyByte...)
+}
+
 // id can not be zero
 func GetMethodPropertyKey(chainId, serviceName string, id int16) []byte {
 	return append(methodPropertyKey, getStringsKey([]string{chainId, serviceName, string(id)})...)
@@ -67,6 +75,11 @@ func GetResponseKey(reqChainId string, eHeight, rHeight int64, counter int16) []
 		string(eHeight), string(rHeight), string(counter)})...)
 }
 
+// Key for getting all responses of the requests initiated in a chain from the store
+func GetResponsesSubspaceKey(reqChainId string) []byte {
+	return append(append(responseKey, []byte(reqChainId)...), emptyByte...)
+}
+
 // get the expiration index of a request
 func GetRequestsByExpirationIndexKeyByReq(req SvcRequest) []byte {
 	return GetRequestsByExpirationIndexKey(req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter)
@@ -99,6 +112,28 @@ func GetIncomingFeeKey(address sdk.AccAddress) []byte {
 	return append(incomingFeeKey, address.Bytes()...)
 }
 
+func GetRequestGroupKey(groupID string) []byte {
+	return append(requestGroupKey, []byte(groupID)...)
+}
+
+func GetGroupResponseKey(groupID string, provider sdk.AccAddress) []byte {
+	return append(groupResponseKey, getStringsKey([]string{groupID, provider.String()})...)
+}
+
+// Key for getting all responses of a request group from the store
+func GetGroupResponsesSubspaceKey(groupID string) []byte {
+	return append(append(groupResponseKey, []byte(groupID)...), emptyByte...)
+}
+
+func GetProfilingStatsKey(defChainId, name, bindChainId string, provider sdk.AccAddress) []byte {
+	return append(profilingStatsKey, getStringsKey([]string{defChainId, name, bindChainId, provider.String()})...)
+}
+
+// Key for getting the profiling stats of all bindings of a service from the store
+func GetProfilingStatsSubspaceKey(defChainId, name string) []byte {
+	return append(append(profilingStatsKey, getStringsKey([]string{defChainId, name})...), emptyByte...)
+}
+
 func getStringsKey(ss []string) (result []byte) {
 	for _, s := range ss {
 		result = append(append(
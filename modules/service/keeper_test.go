@@ -1,11 +1,16 @@
 package service
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/service/tags"
 	sdk "github.com/irisnet/irishub/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 func TestKeeper_service_Definition(t *testing.T) {
@@ -117,6 +122,469 @@ func TestKeeper_service_Call(t *testing.T) {
 	}
 }
 
+func TestKeeper_service_MulticastCall(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	for _, provider := range addrs[:2] {
+		svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+			provider, Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+			Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+		err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+		require.NoError(t, err)
+	}
+
+	// multicast request to all available bindings
+	msg := NewMsgSvcMulticastRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], nil, 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	res := handleMsgSvcMulticastRequest(ctx, keeper, msg)
+	require.True(t, res.IsOK())
+
+	group, found := keeper.GetRequestGroup(ctx, GetGroupID(ctx.BlockHeight(), 0))
+	require.True(t, found)
+	require.Equal(t, 2, len(group.RequestIDs))
+	require.Equal(t, 2, len(group.Providers))
+
+	// only the responding provider is collected in the group
+	respMsg := NewMsgSvcResponse("testnet", group.RequestIDs[0], group.Providers[0], []byte("1234"), nil)
+	res = handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.True(t, res.IsOK())
+
+	iterator := keeper.GetGroupResponses(ctx, group.GroupID)
+	var responses []SvcResponse
+	for ; iterator.Valid(); iterator.Next() {
+		var resp SvcResponse
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &resp)
+		responses = append(responses, resp)
+	}
+	iterator.Close()
+	require.Equal(t, 1, len(responses))
+	require.True(t, responses[0].Provider.Equals(group.Providers[0]))
+}
+
+func TestKeeper_service_ProfilingCall(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1100))})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+	require.NoError(t, err)
+
+	// only profilers can send profiling requests
+	msg := NewMsgSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), nil, true)
+	res := handleMsgSvcRequest(ctx, keeper, msg)
+	require.Equal(t, CodeNotProfiler, res.Code)
+
+	keeper.gk.AddProfiler(ctx, guardian.NewProfiler(addrs[2], addrs[0]))
+	coins := keeper.ck.GetCoins(ctx, addrs[2])
+	res = handleMsgSvcRequest(ctx, keeper, msg)
+	require.True(t, res.IsOK())
+	require.True(t, keeper.ck.GetCoins(ctx, addrs[2]).IsEqual(coins))
+
+	require.Equal(t, tags.ActionSvcProfilingCall, res.Tags[0].Value)
+	require.Equal(t, tags.RequestID, string(res.Tags[1].Key))
+	requestID := string(res.Tags[1].Value)
+
+	eHeight, rHeight, counter, _ := ConvertRequestID(requestID)
+	request, found := keeper.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	require.True(t, found)
+	require.True(t, request.ServiceFee.IsZero())
+
+	// the provider earns no fee from profiling requests
+	ctx = ctx.WithBlockHeight(rHeight + 2)
+	respMsg := NewMsgSvcResponse("testnet", requestID, addrs[1], nil, []byte("error"))
+	res = handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.True(t, res.IsOK())
+	_, found = keeper.GetIncomingFee(ctx, addrs[1])
+	require.False(t, found)
+
+	querier := NewQuerier(keeper)
+	bz, _ := keeper.cdc.MarshalJSON(QueryProfilingParams{DefChainID: "testnet", ServiceName: "myService"})
+	result, qErr := querier(ctx, []string{QueryProfiling}, abci.RequestQuery{Data: bz})
+	require.Nil(t, qErr)
+	var reports []ProfilingReport
+	keeper.cdc.MustUnmarshalJSON(result, &reports)
+	require.Equal(t, 1, len(reports))
+	require.Equal(t, uint64(1), reports[0].Stats.Requests)
+	require.Equal(t, uint64(1), reports[0].Stats.Errors)
+	require.True(t, reports[0].AvgLatency.Equal(sdk.NewDec(2)))
+	require.True(t, reports[0].ErrorRate.Equal(sdk.OneDec()))
+}
+
+func TestKeeper_service_Slash(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1100))})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+	require.NoError(t, err)
+
+	svcRequest := NewSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	svcRequest, err = keeper.AddRequest(ctx, svcRequest)
+	require.NoError(t, err)
+
+	// the request expires without any response
+	ctx = ctx.WithBlockHeight(svcRequest.ExpirationHeight)
+	EndBlocker(ctx, keeper)
+
+	_, found := keeper.GetActiveRequest(ctx, svcRequest.ExpirationHeight, svcRequest.RequestHeight, svcRequest.RequestIntraTxCounter)
+	require.False(t, found)
+
+	// 0.1% of the deposit is slashed and the binding drops below the min deposit
+	binding, found := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.True(t, found)
+	require.True(t, binding.Deposit.IsEqual(sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(999))}))
+	require.False(t, binding.Available)
+
+	feePool := keeper.dk.(distribution.Keeper).GetFeePool(ctx)
+	require.True(t, feePool.CommunityPool.AmountOf("iris").Equal(sdk.NewDec(1)))
+}
+
+func TestKeeper_service_ProfilingTimeout(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1100))})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+	require.NoError(t, err)
+
+	keeper.gk.AddProfiler(ctx, guardian.NewProfiler(addrs[2], addrs[0]))
+	msg := NewMsgSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), nil, true)
+	res := handleMsgSvcRequest(ctx, keeper, msg)
+	require.True(t, res.IsOK())
+	eHeight, rHeight, counter, _ := ConvertRequestID(string(res.Tags[1].Value))
+
+	// the profiling request expires without any response
+	ctx = ctx.WithBlockHeight(eHeight)
+	resTags := EndBlocker(ctx, keeper)
+
+	_, found := keeper.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	require.False(t, found)
+	for _, tag := range resTags {
+		require.NotEqual(t, tags.ActionSvcSlash, tag.Value)
+		require.NotEqual(t, tags.ActionSvcDisable, tag.Value)
+	}
+
+	// nothing was escrowed for the request, nothing is left to refund
+	returnFee, _ := keeper.GetReturnFee(ctx, addrs[2])
+	require.True(t, returnFee.Coins.IsZero())
+
+	// the timeout is counted in the profiling report but the provider is neither slashed nor disabled
+	stats := keeper.GetProfilingStats(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.Equal(t, uint64(1), stats.Timeouts)
+	report := NewProfilingReport(stats)
+	require.True(t, report.ErrorRate.Equal(sdk.OneDec()))
+
+	binding, found := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.True(t, found)
+	require.True(t, binding.Deposit.IsEqual(sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}))
+	require.True(t, binding.Available)
+
+	feePool := keeper.dk.(distribution.Keeper).GetFeePool(ctx)
+	require.True(t, feePool.CommunityPool.AmountOf("iris").IsZero())
+}
+
+func TestKeeper_service_EncryptedOutput(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1100))})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1100))})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		strings.Replace(idlContent, "output_privacy:NoPrivacy", "output_privacy:PubKeyEncryption", 1))
+
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+	require.NoError(t, err)
+
+	svcRequest := NewSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	svcRequest, err = keeper.AddRequest(ctx, svcRequest)
+	require.NoError(t, err)
+
+	// plain output is rejected
+	respMsg := NewMsgSvcResponse("testnet", svcRequest.RequestID(), addrs[1], []byte("secret"), nil)
+	res := handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEncryptedOutput), res.Code)
+
+	consumerKey := secp256k1.GenPrivKey()
+	output, encErr := EncryptOutput(consumerKey.PubKey(), []byte("secret"))
+	require.NoError(t, encErr)
+
+	respMsg = NewMsgSvcResponse("testnet", svcRequest.RequestID(), addrs[1], output, nil)
+	res = handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.True(t, res.IsOK())
+
+	eHeight, rHeight, counter, _ := ConvertRequestID(svcRequest.RequestID())
+	response, found := keeper.GetResponse(ctx, "testnet", eHeight, rHeight, counter)
+	require.True(t, found)
+
+	plain, decErr := DecryptOutput(consumerKey, response.Output)
+	require.NoError(t, decErr)
+	require.Equal(t, []byte("secret"), plain)
+
+	_, decErr = DecryptOutput(secp256k1.GenPrivKey(), response.Output)
+	require.Error(t, decErr)
+}
+
+func TestKeeper_service_CachedOutput(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(3300))})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1100))})
+
+	for _, name := range []string{"myService", "cachedService", "privateService"} {
+		content := idlContent
+		if name != "myService" {
+			content = strings.Replace(content, "output_cached:NoCached", "output_cached:OffChainCached", 1)
+		}
+		if name == "privateService" {
+			content = strings.Replace(content, "output_privacy:NoPrivacy", "output_privacy:PubKeyEncryption", 1)
+		}
+		serviceDef := NewSvcDef(name, "testnet", "the service for unit test", []string{"test", "tutorial"},
+			addrs[0], "unit test author", content)
+		keeper.AddServiceDefinition(ctx, serviceDef)
+		keeper.AddMethods(ctx, serviceDef)
+
+		svcBinding := NewSvcBinding(ctx, "testnet", name, "testnet",
+			addrs[1], Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+			Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+		err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+		require.NoError(t, err)
+	}
+
+	output := []byte("a large output kept off-chain")
+	outputHash := GetOutputHash(output)
+
+	// a cached response needs both the hash and the location
+	respMsg := NewMsgSvcCachedResponse("testnet", "10-0-0", addrs[1], outputHash, "", nil)
+	require.Error(t, respMsg.ValidateBasic())
+	respMsg = NewMsgSvcCachedResponse("testnet", "10-0-0", addrs[1], outputHash[:8], "https://example.com/output", nil)
+	require.Error(t, respMsg.ValidateBasic())
+
+	// the output of a NoCached method must be on chain
+	svcRequest := NewSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	svcRequest, err := keeper.AddRequest(ctx, svcRequest)
+	require.NoError(t, err)
+
+	respMsg = NewMsgSvcCachedResponse("testnet", svcRequest.RequestID(), addrs[1], outputHash, "https://example.com/output", nil)
+	require.NoError(t, respMsg.ValidateBasic())
+	res := handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNotOffChainCached), res.Code)
+
+	// the output of a PubKeyEncryption method can't be cached at a public location
+	svcRequest = NewSvcRequest("testnet", "privateService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	svcRequest, err = keeper.AddRequest(ctx, svcRequest)
+	require.NoError(t, err)
+
+	respMsg = NewMsgSvcCachedResponse("testnet", svcRequest.RequestID(), addrs[1], outputHash, "https://example.com/output", nil)
+	res = handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodePrivateCachedOutput), res.Code)
+
+	svcRequest = NewSvcRequest("testnet", "cachedService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	svcRequest, err = keeper.AddRequest(ctx, svcRequest)
+	require.NoError(t, err)
+
+	respMsg = NewMsgSvcCachedResponse("testnet", svcRequest.RequestID(), addrs[1], outputHash, "https://example.com/output", nil)
+	res = handleMsgSvcResponse(ctx, keeper, respMsg)
+	require.True(t, res.IsOK())
+
+	eHeight, rHeight, counter, _ := ConvertRequestID(svcRequest.RequestID())
+	response, found := keeper.GetResponse(ctx, "testnet", eHeight, rHeight, counter)
+	require.True(t, found)
+	require.Nil(t, response.Output)
+	require.Equal(t, outputHash, response.OutputHash)
+	require.Equal(t, "https://example.com/output", response.OutputLocation)
+}
+
+func TestMsgSvcMulticastRequest_DuplicateProviders(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	msg := NewMsgSvcMulticastRequest("testnet", "myService", "testnet", "testnet",
+		addrs[0], []sdk.AccAddress{addrs[1], addrs[2]}, 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg = NewMsgSvcMulticastRequest("testnet", "myService", "testnet", "testnet",
+		addrs[0], []sdk.AccAddress{addrs[1], addrs[2], addrs[1]}, 1, []byte("1234"), sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1))}, false)
+	err := msg.ValidateBasic()
+	require.NotNil(t, err)
+	require.Equal(t, CodeDuplicateProvider, err.Code())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+
+	// genesis files created before the slashing of expired requests have no slash fraction
+	var genesis GenesisState
+	require.Nil(t, msgCdc.UnmarshalJSON([]byte(`{"MaxRequestTimeout":"100","MinDepositMultiple":"1000"}`), &genesis))
+	require.True(t, genesis.SlashFraction.IsNil())
+	require.Nil(t, ValidateGenesis(genesis))
+
+	genesis.SlashFraction = sdk.NewDecWithPrec(15, 1)
+	require.NotNil(t, ValidateGenesis(genesis))
+	genesis.SlashFraction = sdk.NewDecWithPrec(-1, 1)
+	require.NotNil(t, ValidateGenesis(genesis))
+}
+
+func TestQuerier_service(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(2000))})
+	querier := NewQuerier(keeper)
+
+	for _, name := range []string{"serviceA", "serviceB", "serviceC"} {
+		keeper.AddServiceDefinition(ctx, NewSvcDef(name, "testnet", "the service for unit test",
+			[]string{"test", name}, addrs[0], "unit test author", idlContent))
+	}
+	keeper.AddServiceDefinition(ctx, NewSvcDef("serviceD", "othernet", "the service for unit test",
+		[]string{"test"}, addrs[1], "unit test author", idlContent))
+
+	// query definitions with filters and pagination
+	var defs []SvcDef
+	bz, err := querier(ctx, []string{QueryDefinitions}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryDefinitionsParams{DefChainID: "testnet", Page: 2, Limit: 2}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &defs)
+	require.Equal(t, 1, len(defs))
+	require.Equal(t, "serviceC", defs[0].Name)
+
+	bz, err = querier(ctx, []string{QueryDefinitions}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryDefinitionsParams{Tag: "serviceB"}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &defs)
+	require.Equal(t, 1, len(defs))
+	require.Equal(t, "serviceB", defs[0].Name)
+
+	bz, err = querier(ctx, []string{QueryDefinitions}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryDefinitionsParams{Author: addrs[1]}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &defs)
+	require.Equal(t, 1, len(defs))
+	require.Equal(t, "othernet", defs[0].ChainId)
+
+	// query bindings of a provider
+	for _, name := range []string{"serviceA", "serviceB"} {
+		svcBinding := NewSvcBinding(ctx, "testnet", name, "testnet",
+			addrs[1], Global, sdk.Coins{sdk.NewCoin("iris", sdk.NewInt(1000))}, []sdk.Coin{{"iris", sdk.NewInt(1)}},
+			Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+		err, _ := keeper.AddServiceBinding(ctx, svcBinding)
+		require.NoError(t, err)
+	}
+
+	var bindings []SvcBinding
+	bz, err = querier(ctx, []string{QueryBindings}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryBindingsParams{Provider: addrs[1]}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &bindings)
+	require.Equal(t, 2, len(bindings))
+
+	bz, err = querier(ctx, []string{QueryBindings}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryBindingsParams{Provider: addrs[0]}),
+	})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &bindings)
+	require.Equal(t, 0, len(bindings))
+
+	_, err = querier(ctx, []string{QueryBindings}, abci.RequestQuery{
+		Data: keeper.cdc.MustMarshalJSON(QueryBindingsParams{}),
+	})
+	require.NotNil(t, err)
+
+	// the page size is capped
+	require.Equal(t, uint64(MaxQueryLimit), newPaginator(1, 1<<40).limit)
+}
+
 const idlContent = `
 	syntax = "proto3";
 
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/tools/protoidl"
 	"regexp"
+	"crypto/sha256"
 )
 
 const (
@@ -12,6 +13,8 @@ const (
 	outputPrivacy = "output_privacy"
 	outputCached  = "output_cached"
 	description   = "description"
+
+	MaxOutputLocationLength = 512
 )
 
 var _ sdk.Msg = MsgSvcDef{}
@@ -499,13 +502,97 @@ func (msg MsgSvcRequest) GetSigners() []sdk.AccAddress {
 
 //______________________________________________________________________
 
+// MsgSvcMulticastRequest - struct for call a service on multiple providers
+type MsgSvcMulticastRequest struct {
+	DefChainID  string           `json:"def_chain_id"`
+	DefName     string           `json:"def_name"`
+	BindChainID string           `json:"bind_chain_id"`
+	ReqChainID  string           `json:"req_chain_id"`
+	MethodID    int16            `json:"method_id"`
+	Providers   []sdk.AccAddress `json:"providers"` // all available bindings are called if empty
+	Consumer    sdk.AccAddress   `json:"consumer"`
+	Input       []byte           `json:"input"`
+	ServiceFee  sdk.Coins        `json:"service_fee"` // max service fee paid to each provider
+	Profiling   bool             `json:"profiling"`
+}
+
+func NewMsgSvcMulticastRequest(defChainID, defName, bindChainID, reqChainID string, consumer sdk.AccAddress, providers []sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) MsgSvcMulticastRequest {
+	return MsgSvcMulticastRequest{
+		DefChainID:  defChainID,
+		DefName:     defName,
+		BindChainID: bindChainID,
+		ReqChainID:  reqChainID,
+		Consumer:    consumer,
+		Providers:   providers,
+		MethodID:    methodID,
+		Input:       input,
+		ServiceFee:  serviceFee,
+		Profiling:   profiling,
+	}
+}
+
+func (msg MsgSvcMulticastRequest) Route() string { return MsgType }
+func (msg MsgSvcMulticastRequest) Type() string  { return "service multicast request" }
+
+func (msg MsgSvcMulticastRequest) GetSignBytes() []byte {
+	if len(msg.Input) == 0 {
+		msg.Input = nil
+	}
+	if len(msg.Providers) == 0 {
+		msg.Providers = nil
+	}
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcMulticastRequest) ValidateBasic() sdk.Error {
+	if len(msg.DefChainID) == 0 {
+		return ErrInvalidDefChainId(DefaultCodespace)
+	}
+	if len(msg.BindChainID) == 0 {
+		return ErrInvalidBindChainId(DefaultCodespace)
+	}
+	if len(msg.ReqChainID) == 0 {
+		return ErrInvalidChainId(DefaultCodespace)
+	}
+	if !validServiceName(msg.DefName) {
+		return ErrInvalidServiceName(DefaultCodespace, msg.DefName)
+	}
+	if len(msg.Consumer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Consumer.String())
+	}
+	// a duplicated provider would be charged for and respond in the group twice
+	providers := make(map[string]bool, len(msg.Providers))
+	for _, provider := range msg.Providers {
+		if len(provider) == 0 {
+			return sdk.ErrInvalidAddress(provider.String())
+		}
+		if providers[string(provider)] {
+			return ErrDuplicateProvider(DefaultCodespace, provider)
+		}
+		providers[string(provider)] = true
+	}
+	return nil
+}
+
+func (msg MsgSvcMulticastRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
 // MsgSvcResponse - struct for respond a service call
 type MsgSvcResponse struct {
-	ReqChainID string         `json:"req_chain_id"`
-	RequestID  string         `json:"request_id"`
-	Provider   sdk.AccAddress `json:"provider"`
-	Output     []byte         `json:"output"`
-	ErrorMsg   []byte         `json:"error_msg"`
+	ReqChainID     string         `json:"req_chain_id"`
+	RequestID      string         `json:"request_id"`
+	Provider       sdk.AccAddress `json:"provider"`
+	Output         []byte         `json:"output"`
+	ErrorMsg       []byte         `json:"error_msg"`
+	OutputHash     []byte         `json:"output_hash"`     // sha256 of the off-chain output, OffChainCached methods only
+	OutputLocation string         `json:"output_location"` // where the off-chain output can be fetched
 }
 
 func NewMsgSvcResponse(reqChainID string, requestId string, provider sdk.AccAddress, output, errorMsg []byte) MsgSvcResponse {
@@ -518,6 +605,18 @@ func NewMsgSvcResponse(reqChainID string, requestId string, provider sdk.AccAddr
 	}
 }
 
+// respond an OffChainCached method with the hash and location of the output instead of the output itself
+func NewMsgSvcCachedResponse(reqChainID string, requestId string, provider sdk.AccAddress, outputHash []byte, outputLocation string, errorMsg []byte) MsgSvcResponse {
+	return MsgSvcResponse{
+		ReqChainID:     reqChainID,
+		RequestID:      requestId,
+		Provider:       provider,
+		ErrorMsg:       errorMsg,
+		OutputHash:     outputHash,
+		OutputLocation: outputLocation,
+	}
+}
+
 func (msg MsgSvcResponse) Route() string { return MsgType }
 func (msg MsgSvcResponse) Type() string  { return "service response" }
 
@@ -528,6 +627,9 @@ func (msg MsgSvcResponse) GetSignBytes() []byte {
 	if len(msg.ErrorMsg) == 0 {
 		msg.ErrorMsg = nil
 	}
+	if len(msg.OutputHash) == 0 {
+		msg.OutputHash = nil
+	}
 	b, err := msgCdc.MarshalJSON(msg)
 	if err != nil {
 		panic(err)
@@ -546,6 +648,12 @@ func (msg MsgSvcResponse) ValidateBasic() sdk.Error {
 	if err != nil {
 		return ErrInvalidReqId(DefaultCodespace, msg.RequestID)
 	}
+	if len(msg.OutputHash) > 0 || len(msg.OutputLocation) > 0 {
+		if len(msg.Output) > 0 || len(msg.OutputHash) != sha256.Size ||
+			len(msg.OutputLocation) == 0 || len(msg.OutputLocation) > MaxOutputLocationLength {
+			return ErrInvalidCachedOutput(DefaultCodespace)
+		}
+	}
 
 	return nil
 }
@@ -152,3 +152,75 @@ func (param *MinDepositMultipleParam) Valid(jsonStr string) sdk.Error {
 	}
 	return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMinDepositMultiple, fmt.Sprintf("Json is not valid"))
 }
+
+var SlashFractionParameter SlashFractionParam
+var _ params.SignalParameter = (*SlashFractionParam)(nil)
+
+type SlashFractionParam struct {
+	Value      sdk.Dec
+	paramSpace params.Subspace
+}
+
+func (param *SlashFractionParam) InitGenesis(genesisState interface{}) {
+	param.Value = genesisState.(sdk.Dec)
+}
+
+func (param *SlashFractionParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *SlashFractionParam) GetStoreKey() []byte {
+	return []byte("serviceSlashFraction")
+}
+
+func (param *SlashFractionParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *SlashFractionParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+func (param *SlashFractionParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *SlashFractionParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *SlashFractionParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *SlashFractionParam) Valid(jsonStr string) sdk.Error {
+
+	var err error
+
+	if err = json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		if param.Value.LT(sdk.ZeroDec()) || param.Value.GT(sdk.OneDec()) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSlashFraction, fmt.Sprintf("Invalid SlashFraction [%s] should be between 0 and 1", param.Value.String()))
+		}
+		return nil
+
+	}
+	return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSlashFraction, fmt.Sprintf("Json is not valid"))
+}
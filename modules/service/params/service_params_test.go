@@ -90,3 +90,37 @@ func TestMinProviderDepositParameter(t *testing.T) {
 	MinDepositMultipleParameter.LoadValue(ctx)
 	require.Equal(t, int64(30), MinDepositMultipleParameter.Value)
 }
+
+func TestSlashFractionParameter(t *testing.T) {
+	skey := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	ctx := defaultContext(skey, tkeyParams)
+	cdc := codec.New()
+
+	paramKeeper := params.NewKeeper(
+		cdc,
+		skey, tkeyParams,
+	)
+
+	subspace := paramKeeper.Subspace("Service").WithTypeTable(params.NewTypeTable(
+		SlashFractionParameter.GetStoreKey(), sdk.Dec{},
+	))
+
+	SlashFractionParameter.SetReadWriter(subspace)
+	find := SlashFractionParameter.LoadValue(ctx)
+	require.Equal(t, find, false)
+
+	SlashFractionParameter.InitGenesis(sdk.NewDecWithPrec(1, 3))
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), SlashFractionParameter.Value)
+
+	SlashFractionParameter.Value = sdk.NewDecWithPrec(1, 2)
+	SlashFractionParameter.SaveValue(ctx)
+
+	SlashFractionParameter.LoadValue(ctx)
+	require.True(t, sdk.NewDecWithPrec(1, 2).Equal(SlashFractionParameter.Value))
+
+	require.Nil(t, SlashFractionParameter.Valid(`"0.5"`))
+	require.NotNil(t, SlashFractionParameter.Valid(`"1.5"`))
+	require.NotNil(t, SlashFractionParameter.Valid(`"-0.1"`))
+}
@@ -23,3 +23,13 @@ func SetMaxRequestTimeout(ctx sdk.Context, i int64) {
 	MaxRequestTimeoutParameter.Value = i
 	MaxRequestTimeoutParameter.SaveValue(ctx)
 }
+
+func GetSlashFraction(ctx sdk.Context) sdk.Dec {
+	SlashFractionParameter.LoadValue(ctx)
+	return SlashFractionParameter.Value
+}
+
+func SetSlashFraction(ctx sdk.Context, fraction sdk.Dec) {
+	SlashFractionParameter.Value = fraction
+	SlashFractionParameter.SaveValue(ctx)
+}
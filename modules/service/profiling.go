@@ -0,0 +1,50 @@
+package service
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// ProfilingStats accumulates the profiling calls of a service binding, the latency is measured in blocks
+// from the request to the response
+type ProfilingStats struct {
+	DefChainID   string         `json:"def_chain_id"`
+	DefName      string         `json:"def_name"`
+	BindChainID  string         `json:"bind_chain_id"`
+	Provider     sdk.AccAddress `json:"provider"`
+	Requests     uint64         `json:"requests"`
+	Responses    uint64         `json:"responses"`
+	Errors       uint64         `json:"errors"`   // responses with an error message
+	Timeouts     uint64         `json:"timeouts"` // requests expired without response
+	TotalLatency int64          `json:"total_latency"`
+}
+
+func NewProfilingStats(defChainID, defName, bindChainID string, provider sdk.AccAddress) ProfilingStats {
+	return ProfilingStats{
+		DefChainID:  defChainID,
+		DefName:     defName,
+		BindChainID: bindChainID,
+		Provider:    provider,
+	}
+}
+
+// ProfilingReport summarizes the profiling stats of a service binding
+type ProfilingReport struct {
+	Stats      ProfilingStats `json:"stats"`
+	AvgLatency sdk.Dec        `json:"avg_latency"` // average blocks of the responses
+	ErrorRate  sdk.Dec        `json:"error_rate"`  // errors and timeouts of the finished requests
+}
+
+func NewProfilingReport(stats ProfilingStats) ProfilingReport {
+	report := ProfilingReport{
+		Stats:      stats,
+		AvgLatency: sdk.ZeroDec(),
+		ErrorRate:  sdk.ZeroDec(),
+	}
+	if stats.Responses > 0 {
+		report.AvgLatency = sdk.NewDec(stats.TotalLatency).Quo(sdk.NewDec(int64(stats.Responses)))
+	}
+	if finished := stats.Responses + stats.Timeouts; finished > 0 {
+		report.ErrorRate = sdk.NewDec(int64(stats.Errors + stats.Timeouts)).Quo(sdk.NewDec(int64(finished)))
+	}
+	return report
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the service Querier
+const (
+	QueryDefinitions = "definitions"
+	QueryDefinition  = "definition"
+	QueryBindings    = "bindings"
+	QueryRequests    = "requests"
+	QueryResponses   = "responses"
+	QueryProfiling   = "profiling"
+
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryDefinitions:
+			return queryDefinitions(ctx, path[1:], req, keeper)
+		case QueryDefinition:
+			return queryDefinition(ctx, path[1:], req, keeper)
+		case QueryBindings:
+			return queryBindings(ctx, path[1:], req, keeper)
+		case QueryRequests:
+			return queryRequests(ctx, path[1:], req, keeper)
+		case QueryResponses:
+			return queryResponses(ctx, path[1:], req, keeper)
+		case QueryProfiling:
+			return queryProfiling(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown service query endpoint")
+		}
+	}
+}
+
+type DefinitionOutput struct {
+	Definition SvcDef           `json:"definition"`
+	Methods    []MethodProperty `json:"methods"`
+}
+
+// Params for query 'custom/service/definitions'
+type QueryDefinitionsParams struct {
+	DefChainID string
+	Tag        string
+	Author     sdk.AccAddress
+	Page       uint64
+	Limit      uint64
+}
+
+// nolint: unparam
+func queryDefinitions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryDefinitionsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	definitions := []SvcDef{}
+	p := newPaginator(params.Page, params.Limit)
+	iterator := keeper.GetServiceDefinitions(ctx, params.DefChainID)
+	defer iterator.Close()
+	for ; iterator.Valid() && !p.full(); iterator.Next() {
+		var svcDef SvcDef
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &svcDef)
+		if len(params.Tag) > 0 && !hasTag(svcDef.Tags, params.Tag) {
+			continue
+		}
+		if len(params.Author) > 0 && !svcDef.Author.Equals(params.Author) {
+			continue
+		}
+		if p.accept() {
+			definitions = append(definitions, svcDef)
+		}
+	}
+
+	return marshalQueryResult(keeper.cdc, definitions)
+}
+
+// Params for query 'custom/service/definition'
+type QueryDefinitionParams struct {
+	DefChainID  string
+	ServiceName string
+}
+
+// nolint: unparam
+func queryDefinition(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryDefinitionParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	svcDef, found := keeper.GetServiceDefinition(ctx, params.DefChainID, params.ServiceName)
+	if !found {
+		return nil, ErrSvcDefNotExists(DefaultCodespace, params.DefChainID, params.ServiceName)
+	}
+
+	methods := []MethodProperty{}
+	iterator := keeper.GetMethods(ctx, params.DefChainID, params.ServiceName)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var method MethodProperty
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &method)
+		methods = append(methods, method)
+	}
+
+	return marshalQueryResult(keeper.cdc, DefinitionOutput{Definition: svcDef, Methods: methods})
+}
+
+// Params for query 'custom/service/bindings', the bindings of a definition if DefChainID and ServiceName
+// are given, otherwise the bindings of the provider
+type QueryBindingsParams struct {
+	DefChainID  string
+	ServiceName string
+	Provider    sdk.AccAddress
+	Page        uint64
+	Limit       uint64
+}
+
+// nolint: unparam
+func queryBindings(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryBindingsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+
+	var iterator sdk.Iterator
+	if len(params.DefChainID) > 0 && len(params.ServiceName) > 0 {
+		iterator = keeper.GetServiceBindings(ctx, params.DefChainID, params.ServiceName)
+	} else if len(params.Provider) > 0 {
+		iterator = keeper.GetAllServiceBindings(ctx)
+	} else {
+		return nil, sdk.ErrUnknownRequest("either the service definition or the provider is required")
+	}
+	defer iterator.Close()
+
+	bindings := []SvcBinding{}
+	p := newPaginator(params.Page, params.Limit)
+	for ; iterator.Valid() && !p.full(); iterator.Next() {
+		var binding SvcBinding
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &binding)
+		if len(params.Provider) > 0 && !binding.Provider.Equals(params.Provider) {
+			continue
+		}
+		if p.accept() {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return marshalQueryResult(keeper.cdc, bindings)
+}
+
+// Params for query 'custom/service/requests', the active requests of the consumer or the provider
+type QueryRequestsParams struct {
+	Consumer sdk.AccAddress
+	Provider sdk.AccAddress
+	Page     uint64
+	Limit    uint64
+}
+
+// nolint: unparam
+func queryRequests(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRequestsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+	if len(params.Consumer) == 0 && len(params.Provider) == 0 {
+		return nil, sdk.ErrUnknownRequest("either the consumer or the provider is required")
+	}
+
+	requests := []SvcRequest{}
+	p := newPaginator(params.Page, params.Limit)
+	iterator := keeper.ActiveAllRequestQueueIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid() && !p.full(); iterator.Next() {
+		var request SvcRequest
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &request)
+		if !matchParticipants(request.Consumer, request.Provider, params.Consumer, params.Provider) {
+			continue
+		}
+		if p.accept() {
+			requests = append(requests, request)
+		}
+	}
+
+	return marshalQueryResult(keeper.cdc, requests)
+}
+
+// Params for query 'custom/service/responses', the responses of the requests initiated in ReqChainID
+type QueryResponsesParams struct {
+	ReqChainID string
+	Consumer   sdk.AccAddress
+	Provider   sdk.AccAddress
+	Page       uint64
+	Limit      uint64
+}
+
+// nolint: unparam
+func queryResponses(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryResponsesParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+	if len(params.ReqChainID) == 0 {
+		return nil, ErrInvalidReqChainId(DefaultCodespace)
+	}
+
+	responses := []SvcResponse{}
+	p := newPaginator(params.Page, params.Limit)
+	iterator := keeper.GetResponses(ctx, params.ReqChainID)
+	defer iterator.Close()
+	for ; iterator.Valid() && !p.full(); iterator.Next() {
+		var response SvcResponse
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &response)
+		if !matchParticipants(response.Consumer, response.Provider, params.Consumer, params.Provider) {
+			continue
+		}
+		if p.accept() {
+			responses = append(responses, response)
+		}
+	}
+
+	return marshalQueryResult(keeper.cdc, responses)
+}
+
+// Params for query 'custom/service/profiling', the profiling reports of the bindings of a service,
+// filtered by the provider if given
+type QueryProfilingParams struct {
+	DefChainID  string
+	ServiceName string
+	Provider    sdk.AccAddress
+}
+
+// nolint: unparam
+func queryProfiling(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryProfilingParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err2.Error()))
+	}
+	if _, found := keeper.GetServiceDefinition(ctx, params.DefChainID, params.ServiceName); !found {
+		return nil, ErrSvcDefNotExists(DefaultCodespace, params.DefChainID, params.ServiceName)
+	}
+
+	reports := []ProfilingReport{}
+	iterator := keeper.GetAllProfilingStats(ctx, params.DefChainID, params.ServiceName)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats ProfilingStats
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stats)
+		if len(params.Provider) > 0 && !stats.Provider.Equals(params.Provider) {
+			continue
+		}
+		reports = append(reports, NewProfilingReport(stats))
+	}
+
+	return marshalQueryResult(keeper.cdc, reports)
+}
+
+//______________________________________________________________________
+
+// paginator selects the matched items of a page, pages start from 1
+type paginator struct {
+	skip  uint64
+	limit uint64
+}
+
+func newPaginator(page, limit uint64) *paginator {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+	return &paginator{skip: (page - 1) * limit, limit: limit}
+}
+
+// accept a matched item, returns true if it is on the page
+func (p *paginator) accept() bool {
+	if p.skip > 0 {
+		p.skip--
+		return false
+	}
+	if p.limit == 0 {
+		return false
+	}
+	p.limit--
+	return true
+}
+
+// is the page full?
+func (p *paginator) full() bool {
+	return p.skip == 0 && p.limit == 0
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// empty filters match any participant
+func matchParticipants(consumer, provider, consumerFilter, providerFilter sdk.AccAddress) bool {
+	if len(consumerFilter) > 0 && !consumer.Equals(consumerFilter) {
+		return false
+	}
+	if len(providerFilter) > 0 && !provider.Equals(providerFilter) {
+		return false
+	}
+	return true
+}
+
+func marshalQueryResult(cdc *codec.Codec, result interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
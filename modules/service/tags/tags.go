@@ -12,16 +12,23 @@ var (
 	ActionSvcDisable       = []byte("service-disable")
 	ActionSvcEnable        = []byte("service-enable")
 
-	ActionSvcCall         = []byte("service-call")
-	ActionSvcRespond      = []byte("service-respond")
-	ActionSvcRefundFees   = []byte("service-refund-fees")
-	ActionSvcWithdrawFees = []byte("service-withdraw-fees")
+	ActionSvcCall                   = []byte("service-call")
+	ActionSvcMulticastCall          = []byte("service-multicast-call")
+	ActionSvcProfilingCall          = []byte("service-profiling-call")
+	ActionSvcProfilingMulticastCall = []byte("service-profiling-multicast-call")
+	ActionSvcRespond                = []byte("service-respond")
+	ActionSvcRefundFees             = []byte("service-refund-fees")
+	ActionSvcWithdrawFees           = []byte("service-withdraw-fees")
 
 	ActionSvcCallTimeOut = []byte("service-call-expiration")
+	ActionSvcSlash       = []byte("service-slash")
 
 	Action = sdk.TagAction
 
-	Provider = "provider"
-	Consumer = "consumer"
+	Provider  = "provider"
+	Consumer  = "consumer"
 	RequestID = "request-id"
+	GroupID   = "group-id"
+	Slashed   = "slashed"
+	Profiling = "profiling"
 )
@@ -13,6 +13,8 @@ import (
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/simulation/mock"
 	"github.com/irisnet/irishub/modules/stake"
 	"github.com/irisnet/irishub/types"
@@ -27,21 +29,26 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	RegisterCodec(mapp.Cdc)
 
 	keyService := sdk.NewKVStoreKey("service")
+	keyDistr := sdk.NewKVStoreKey("distr")
+	keyGuardian := sdk.NewKVStoreKey("guardian")
 
-	ck := bank.NewBaseKeeper(mapp.AccountKeeper)
+	ck := bank.NewBaseKeeper(mapp.Cdc, mapp.KeyBank, mapp.AccountKeeper)
 	sk := stake.NewKeeper(
 		mapp.Cdc,
 		mapp.KeyStake, mapp.TkeyStake,
 		mapp.BankKeeper, mapp.ParamsKeeper.Subspace(stake.DefaultParamspace),
 		mapp.RegisterCodespace(stake.DefaultCodespace))
-	ik := NewKeeper(mapp.Cdc, keyService, ck, DefaultCodespace)
+	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, mapp.ParamsKeeper.Subspace(distribution.DefaultParamspace),
+		ck, sk, mapp.FeeCollectionKeeper, distribution.DefaultCodespace)
+	gk := guardian.NewKeeper(mapp.Cdc, keyGuardian, guardian.DefaultCodespace)
+	ik := NewKeeper(mapp.Cdc, keyService, ck, dk, gk, DefaultCodespace)
 
-	mapp.Router().AddRoute("service", []*sdk.KVStoreKey{keyService}, NewHandler(ik))
+	mapp.Router().AddRoute("service", []*sdk.KVStoreKey{keyService, keyGuardian}, NewHandler(ik))
 
 	mapp.SetEndBlocker(getEndBlocker())
-	mapp.SetInitChainer(getInitChainer(mapp, sk))
+	mapp.SetInitChainer(getInitChainer(mapp, sk, dk))
 
-	require.NoError(t, mapp.CompleteSetup(keyService))
+	require.NoError(t, mapp.CompleteSetup(keyService, keyDistr, keyGuardian))
 
 	coin, _ := types.NewDefaultCoinType("iris").ConvertToMinCoin(fmt.Sprintf("%d%s", 1042, "iris"))
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
@@ -60,7 +67,7 @@ func getEndBlocker() sdk.EndBlocker {
 }
 
 // gov and stake initchainer
-func getInitChainer(mapp *mock.App, stakeKeeper stake.Keeper) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, stakeKeeper stake.Keeper, distrKeeper distribution.Keeper) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
 
@@ -71,6 +78,7 @@ func getInitChainer(mapp *mock.App, stakeKeeper stake.Keeper) sdk.InitChainer {
 		if err != nil {
 			panic(err)
 		}
+		distribution.InitGenesis(ctx, distrKeeper, distribution.DefaultGenesisState())
 		InitGenesis(ctx, DefaultGenesisState())
 		return abci.ResponseInitChain{
 			Validators: validators,
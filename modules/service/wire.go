@@ -13,6 +13,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSvcEnable{}, "iris-hub/service/MsgSvcEnable", nil)
 	cdc.RegisterConcrete(MsgSvcRefundDeposit{}, "iris-hub/service/MsgSvcRefundDeposit", nil)
 	cdc.RegisterConcrete(MsgSvcRequest{}, "iris-hub/service/MsgSvcRequest", nil)
+	cdc.RegisterConcrete(MsgSvcMulticastRequest{}, "iris-hub/service/MsgSvcMulticastRequest", nil)
 	cdc.RegisterConcrete(MsgSvcResponse{}, "iris-hub/service/MsgSvcResponse", nil)
 	cdc.RegisterConcrete(MsgSvcRefundFees{}, "iris-hub/service/MsgSvcRefundFees", nil)
 	cdc.RegisterConcrete(MsgSvcWithdrawFees{}, "iris-hub/service/MsgSvcWithdrawFees", nil)
@@ -21,6 +22,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(SvcBinding{}, "iris-hub/service/SvcBinding", nil)
 	cdc.RegisterConcrete(SvcRequest{}, "iris-hub/service/SvcRequest", nil)
 	cdc.RegisterConcrete(SvcResponse{}, "iris-hub/service/SvcResponse", nil)
+	cdc.RegisterConcrete(RequestGroup{}, "iris-hub/service/RequestGroup", nil)
 	cdc.RegisterConcrete(ReturnedFee{}, "iris-hub/service/ReturnedFee", nil)
 	cdc.RegisterConcrete(IncomingFee{}, "iris-hub/service/IncomingFee", nil)
 }
@@ -106,10 +106,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// Deduct from validator's bonded tokens and update the validator.
 	// The deducted tokens are returned to pool.LooseTokens.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
-	pool := k.GetPool(ctx)
 	// Burn the slashed tokens, which are now loose.
-	pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
-	k.SetPool(ctx, pool)
+	k.burnLooseTokens(ctx, tokensToBurn)
 
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
@@ -174,12 +172,10 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 	if !unbondingSlashAmount.IsZero() {
 		unbondingDelegation.Balance.Amount = unbondingDelegation.Balance.Amount.Sub(unbondingSlashAmount)
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
-		pool := k.GetPool(ctx)
 
 		// Burn loose tokens
 		// Ref https://github.com/irisnet/irishub/pull/1278#discussion_r198657760
-		pool.LooseTokens = pool.LooseTokens.Sub(sdk.NewDecFromInt(unbondingSlashAmount))
-		k.SetPool(ctx, pool)
+		k.burnLooseTokens(ctx, sdk.NewDecFromInt(unbondingSlashAmount))
 	}
 
 	return
@@ -240,10 +236,20 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		}
 
 		// Burn loose tokens
-		pool := k.GetPool(ctx)
-		pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
-		k.SetPool(ctx, pool)
+		k.burnLooseTokens(ctx, tokensToBurn)
 	}
 
 	return slashAmount
 }
+
+// burn loose tokens, taking them out of the pool and out of the bank supply
+// the supply only counts whole tokens, the fraction of a token is burned from the pool only
+func (k Keeper) burnLooseTokens(ctx sdk.Context, tokens sdk.Dec) {
+	pool := k.GetPool(ctx)
+	pool.LooseTokens = pool.LooseTokens.Sub(tokens)
+	k.SetPool(ctx, pool)
+
+	if burned := tokens.TruncateInt(); burned.Sign() > 0 {
+		k.bankKeeper.DecreaseSupply(ctx, sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), burned)})
+	}
+}
@@ -13,6 +13,9 @@ const (
 	CodeNotCurrentProposal      sdk.CodeType = 102
 	CodeNotValidator            sdk.CodeType = 103
 	CodeDoubleSwitch            sdk.CodeType = 104
+	CodeInvalidSoftwareHash     sdk.CodeType = 105
+	CodeSoftwareMismatch        sdk.CodeType = 106
+	CodeMigrationFailed         sdk.CodeType = 107
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -10,7 +10,8 @@ import (
 
 // GenesisState - all upgrade state that must be provided at genesis
 type GenesisState struct {
-	SwitchPeriod int64    `json:"switch_period"`
+	SwitchPeriod    int64   `json:"switch_period"`
+	SwitchThreshold sdk.Dec `json:"switch_threshold"`
 }
 
 // InitGenesis - build the genesis version For first Version
@@ -30,6 +31,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, router bam.Router, data GenesisState
 	params.InitGenesisParameter(&upgradeparams.ProposalAcceptHeightParameter, ctx, -1)
 	params.InitGenesisParameter(&upgradeparams.CurrentUpgradeProposalIdParameter, ctx, 0)
 	params.InitGenesisParameter(&upgradeparams.SwitchPeriodParameter, ctx, data.SwitchPeriod)
+	params.InitGenesisParameter(&upgradeparams.UpgradeConfigParameter, ctx, nil)
+	params.InitGenesisParameter(&upgradeparams.SwitchThresholdParameter, ctx, data.SwitchThreshold)
+	params.InitGenesisParameter(&upgradeparams.AbortUpgradeProposalIdParameter, ctx, nil)
 
 	InitGenesis_commitID(ctx, k)
 }
@@ -39,20 +43,23 @@ func InitGenesis(ctx sdk.Context, k Keeper, router bam.Router, data GenesisState
 func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 	return GenesisState{
-		SwitchPeriod: upgradeparams.GetSwitchPeriod(ctx),
+		SwitchPeriod:    upgradeparams.GetSwitchPeriod(ctx),
+		SwitchThreshold: upgradeparams.GetSwitchThreshold(ctx),
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		SwitchPeriod: 57600,
+		SwitchPeriod:    57600,
+		SwitchThreshold: sdk.NewDecWithPrec(95, 2),
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisStateForTest() GenesisState {
 	return GenesisState{
-		SwitchPeriod: 15,
+		SwitchPeriod:    15,
+		SwitchThreshold: sdk.NewDecWithPrec(95, 2),
 	}
 }
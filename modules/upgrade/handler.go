@@ -4,6 +4,7 @@ import (
 	"fmt"
 	sdk "github.com/irisnet/irishub/types"
 	"reflect"
+	"strings"
 	"github.com/irisnet/irishub/modules/upgrade/params"
 )
 
@@ -45,6 +46,10 @@ func handlerSwitch(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 		return NewError(DefaultCodespace, CodeDoubleSwitch, "You have sent the switch msg").Result()
 	}
 
+	if checksum := upgradeparams.GetUpgradeConfig(ctx).Checksum; len(checksum) > 0 && !strings.EqualFold(checksum, msgSwitch.SoftwareHash) {
+		return NewError(DefaultCodespace, CodeSoftwareMismatch, fmt.Sprintf("The software hash %s doesn't match the checksum %s of the proposal", msgSwitch.SoftwareHash, checksum)).Result()
+	}
+
 	k.SetSwitch(ctx, proposalID, voter, msgSwitch)
 
 	return sdk.Result{
@@ -57,20 +62,32 @@ func handlerSwitch(ctx sdk.Context, msg sdk.Msg, k Keeper) sdk.Result {
 func EndBlocker(ctx sdk.Context, keeper Keeper) (tags sdk.Tags) {
 	tags = sdk.NewTags()
 
-	height := upgradeparams.GetProposalAcceptHeight(ctx)
 	proposalID := upgradeparams.GetCurrentUpgradeProposalId(ctx)
-	switchPeriod := upgradeparams.GetSwitchPeriod(ctx)
 
-	if (proposalID != 0) && (ctx.BlockHeight() == height + switchPeriod) {
-		switchPasses := tally(ctx, keeper)
+	// an abort proposal passed in this block, the switch can't be aborted once it has passed
+	if abortID := upgradeparams.GetAbortUpgradeProposalId(ctx); abortID != 0 {
+		upgradeparams.SetAbortUpgradeProposalId(ctx, 0)
+		if abortID == proposalID && !keeper.GetDoingSwitch(ctx) {
+			tags = tags.AppendTag("action", []byte("switchAborted"))
+
+			keeper.AddUpgradeAttempt(ctx, UpgradeAttemptAborted)
+			resetCurrentUpgrade(ctx)
+			proposalID = 0
+		}
+	}
+
+	if (proposalID != 0) && (ctx.BlockHeight() == upgradeparams.GetSwitchHeight(ctx)) {
+		switchPasses := tally(ctx, keeper) && keeper.validProtocolVersion(ctx)
 		if switchPasses {
 			tags.AppendTag("action", []byte("switchPassed"))
 
+			keeper.AddUpgradeAttempt(ctx, UpgradeAttemptPassed)
 			keeper.DoSwitchBegin(ctx)
 		} else {
 			tags.AppendTag("action", []byte("switchDropped"))
 
-			upgradeparams.SetCurrentUpgradeProposalId(ctx,0)
+			keeper.AddUpgradeAttempt(ctx, UpgradeAttemptDropped)
+			resetCurrentUpgrade(ctx)
 		}
 	}
 
@@ -78,7 +95,9 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (tags sdk.Tags) {
 	if keeper.GetDoingSwitch(ctx) && (&blockHeader).GetNumTxs() == 0 {
 		tags.AppendTag("action", []byte("readyToDoSwitch"))
 
-		keeper.DoSwitchEnd(ctx)
+		if err := keeper.DoSwitchEnd(ctx); err != nil {
+			tags = tags.AppendTag("action", []byte("switchFailed"))
+		}
 	}
     fmt.Println(keeper.GetCurrentVersion(ctx))
 	return tags
@@ -1,6 +1,7 @@
 package upgrade
 
 import (
+	"errors"
 	"fmt"
 	"github.com/irisnet/irishub/baseapp"
 	sdk "github.com/irisnet/irishub/types"
@@ -9,6 +10,7 @@ import (
 	"testing"
 	"github.com/irisnet/irishub/modules/upgrade/params"
 	"github.com/irisnet/irishub/modules/params"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestUpdateKeeper(t *testing.T) {
@@ -142,16 +144,173 @@ func TestSetKVStoreKeylist(t *testing.T) {
 		upgradeparams.CurrentUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
 		upgradeparams.ProposalAcceptHeightParameter.GetStoreKey(), int64(0),
 		upgradeparams.SwitchPeriodParameter.GetStoreKey(), int64(0),
+		upgradeparams.UpgradeConfigParameter.GetStoreKey(), upgradeparams.UpgradeConfig{},
+		upgradeparams.SwitchThresholdParameter.GetStoreKey(), sdk.Dec{},
+		upgradeparams.AbortUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
 	))
 
 	upgradeparams.ProposalAcceptHeightParameter.SetReadWriter(subspace)
 	upgradeparams.CurrentUpgradeProposalIdParameter.SetReadWriter(subspace)
 	upgradeparams.SwitchPeriodParameter.SetReadWriter(subspace)
+	upgradeparams.UpgradeConfigParameter.SetReadWriter(subspace)
+	upgradeparams.SwitchThresholdParameter.SetReadWriter(subspace)
+	upgradeparams.AbortUpgradeProposalIdParameter.SetReadWriter(subspace)
 
 	InitGenesis(ctx, keeper, router, DefaultGenesisStateForTest())
 	keeper.SetKVStoreKeylist(ctx)
 }
 
+func TestUpgradeAttempts(t *testing.T) {
+	ctx, keeper, paramKeeper := createTestInput(t)
+
+	subspace := paramKeeper.Subspace("Sig").WithTypeTable(params.NewTypeTable(
+		upgradeparams.CurrentUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
+		upgradeparams.UpgradeConfigParameter.GetStoreKey(), upgradeparams.UpgradeConfig{},
+		upgradeparams.SwitchThresholdParameter.GetStoreKey(), sdk.Dec{},
+	))
+	upgradeparams.CurrentUpgradeProposalIdParameter.SetReadWriter(subspace)
+	upgradeparams.UpgradeConfigParameter.SetReadWriter(subspace)
+	upgradeparams.SwitchThresholdParameter.SetReadWriter(subspace)
+	upgradeparams.SetSwitchThreshold(ctx, sdk.NewDecWithPrec(95, 2))
+
+	config := upgradeparams.UpgradeConfig{ProtocolVersion: 1, SwitchHeight: 100}
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 2)
+	upgradeparams.SetUpgradeConfig(ctx, config)
+	keeper.AddUpgradeAttempt(ctx, UpgradeAttemptDropped)
+
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 1)
+	keeper.AddUpgradeAttempt(ctx, UpgradeAttemptAborted)
+
+	attempt, found := keeper.GetUpgradeAttempt(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, UpgradeAttemptDropped, attempt.Status)
+	require.Equal(t, config, attempt.Config)
+
+	attempts := keeper.GetUpgradeAttempts(ctx)
+	require.Equal(t, 2, len(attempts))
+	require.Equal(t, uint64(1), attempts[0].ProposalID)
+	require.Equal(t, UpgradeAttemptAborted, attempts[0].Status)
+}
+
+func TestEndBlocker_AbortUpgrade(t *testing.T) {
+	ctx, keeper, paramKeeper := createTestInput(t)
+	setUpgradeParams(ctx, paramKeeper)
+	upgradeparams.SetSwitchThreshold(ctx, sdk.NewDecWithPrec(95, 2))
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 50, NumTxs: 1})
+
+	config := upgradeparams.UpgradeConfig{ProtocolVersion: 1, SwitchHeight: 100}
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 1)
+	upgradeparams.SetUpgradeConfig(ctx, config)
+
+	// an abort of another proposal is dropped
+	upgradeparams.SetAbortUpgradeProposalId(ctx, 2)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, uint64(0), upgradeparams.GetAbortUpgradeProposalId(ctx))
+	require.Equal(t, uint64(1), upgradeparams.GetCurrentUpgradeProposalId(ctx))
+
+	// the switch period ends once the current proposal is aborted
+	upgradeparams.SetAbortUpgradeProposalId(ctx, 1)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, uint64(0), upgradeparams.GetAbortUpgradeProposalId(ctx))
+	require.Equal(t, uint64(0), upgradeparams.GetCurrentUpgradeProposalId(ctx))
+	require.Equal(t, upgradeparams.UpgradeConfig{}, upgradeparams.GetUpgradeConfig(ctx))
+	attempt, found := keeper.GetUpgradeAttempt(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, UpgradeAttemptAborted, attempt.Status)
+	require.Equal(t, config, attempt.Config)
+
+	// an abort after the switch has begun is ignored
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 3)
+	upgradeparams.SetUpgradeConfig(ctx, config)
+	keeper.DoSwitchBegin(ctx)
+	upgradeparams.SetAbortUpgradeProposalId(ctx, 3)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, uint64(0), upgradeparams.GetAbortUpgradeProposalId(ctx))
+	require.Equal(t, uint64(3), upgradeparams.GetCurrentUpgradeProposalId(ctx))
+	require.Equal(t, config, upgradeparams.GetUpgradeConfig(ctx))
+	require.True(t, keeper.GetDoingSwitch(ctx))
+	_, found = keeper.GetUpgradeAttempt(ctx, 3)
+	require.False(t, found)
+}
+
+func TestRunMigrations(t *testing.T) {
+	ctx, keeper, _ := createTestInput(t)
+
+	// the migrations registered by the test are dropped afterwards
+	defer func(bucket map[int64][]Migration) { MigrationBucket = bucket }(MigrationBucket)
+	MigrationBucket = nil
+
+	key := []byte("migrated")
+	var runs []string
+	RegisterMigrations(1, []Migration{
+		{"upgrade", "first", func(ctx sdk.Context) error {
+			runs = append(runs, "first")
+			ctx.KVStore(keeper.storeKey).Set(key, []byte("v1"))
+			return nil
+		}},
+		{"upgrade", "second", func(ctx sdk.Context) error {
+			runs = append(runs, "second")
+			return nil
+		}},
+	})
+	RegisterMigrations(2, []Migration{
+		{"upgrade", "third", func(ctx sdk.Context) error {
+			ctx.KVStore(keeper.storeKey).Set(key, []byte("v2"))
+			return errors.New("failed")
+		}},
+	})
+
+	results, err := keeper.RunMigrations(ctx, 1)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(results))
+	require.Equal(t, []string{"first", "second"}, runs)
+	require.True(t, keeper.HasMigrated(ctx, 1))
+
+	// the migrations of a version run only once
+	results, err = keeper.RunMigrations(ctx, 1)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(results))
+	require.Equal(t, []string{"first", "second"}, runs)
+
+	// a failed migration writes nothing
+	results, err = keeper.RunMigrations(ctx, 2)
+	require.NotNil(t, err)
+	require.Equal(t, "failed", results[0].Error)
+	require.False(t, keeper.HasMigrated(ctx, 2))
+	require.Equal(t, []byte("v1"), ctx.KVStore(keeper.storeKey).Get(key))
+}
+
+func TestDoSwitchEnd_MigrationFailed(t *testing.T) {
+	ctx, keeper, paramKeeper := createTestInput(t)
+	setUpgradeParams(ctx, paramKeeper)
+	upgradeparams.SetSwitchThreshold(ctx, sdk.NewDecWithPrec(95, 2))
+	keeper.AddNewVersion(ctx, NewVersion(0, 0, 0, NewModuleLifeTimeList()))
+
+	RegisterMigrations(1, []Migration{
+		{"upgrade", "failing", func(ctx sdk.Context) error {
+			return errors.New("failed")
+		}},
+	})
+
+	config := upgradeparams.UpgradeConfig{ProtocolVersion: 1, SwitchHeight: 100}
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 1)
+	upgradeparams.SetUpgradeConfig(ctx, config)
+	keeper.DoSwitchBegin(ctx)
+
+	// the switch is aborted and the chain keeps running the current version
+	require.NotNil(t, keeper.DoSwitchEnd(ctx))
+	require.Equal(t, int64(0), keeper.GetCurrentVersion(ctx).Id)
+	require.False(t, keeper.GetDoingSwitch(ctx))
+	require.Equal(t, uint64(0), upgradeparams.GetCurrentUpgradeProposalId(ctx))
+	require.Equal(t, upgradeparams.UpgradeConfig{}, upgradeparams.GetUpgradeConfig(ctx))
+	require.False(t, keeper.HasMigrated(ctx, 1))
+
+	attempt, found := keeper.GetUpgradeAttempt(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, UpgradeAttemptFailed, attempt.Status)
+	require.Equal(t, config, attempt.Config)
+}
+
 func getModuleList(router baseapp.Router) ModuleLifeTimeList {
 
 	modulelist := NewModuleLifeTimeList()
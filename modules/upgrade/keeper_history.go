@@ -0,0 +1,51 @@
+package upgrade
+
+import (
+	"github.com/irisnet/irishub/modules/upgrade/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// AddUpgradeAttempt records how the switch period of the current upgrade proposal ended
+func (k Keeper) AddUpgradeAttempt(ctx sdk.Context, status UpgradeAttemptStatus) {
+	proposalID := upgradeparams.GetCurrentUpgradeProposalId(ctx)
+	switchedPower := sdk.ZeroDec()
+	if readiness := k.GetReadiness(ctx); readiness.TotalPower.GT(sdk.ZeroDec()) {
+		switchedPower = readiness.SwitchedPower.Quo(readiness.TotalPower)
+	}
+	attempt := NewUpgradeAttempt(proposalID, status, ctx.BlockHeight(), upgradeparams.GetUpgradeConfig(ctx), switchedPower)
+
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Set(GetUpgradeAttemptKey(proposalID), k.cdc.MustMarshalBinaryLengthPrefixed(attempt))
+}
+
+func (k Keeper) GetUpgradeAttempt(ctx sdk.Context, proposalID uint64) (UpgradeAttempt, bool) {
+	kvStore := ctx.KVStore(k.storeKey)
+	bz := kvStore.Get(GetUpgradeAttemptKey(proposalID))
+	if bz == nil {
+		return UpgradeAttempt{}, false
+	}
+	var attempt UpgradeAttempt
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &attempt)
+	return attempt, true
+}
+
+// GetUpgradeAttempts returns the upgrade attempts ordered by proposal id
+func (k Keeper) GetUpgradeAttempts(ctx sdk.Context) []UpgradeAttempt {
+	kvStore := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(kvStore, UpgradeAttemptsSubspace)
+	defer iterator.Close()
+
+	attempts := []UpgradeAttempt{}
+	for ; iterator.Valid(); iterator.Next() {
+		var attempt UpgradeAttempt
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &attempt)
+		attempts = append(attempts, attempt)
+	}
+	return attempts
+}
+
+// resetCurrentUpgrade ends the switch period of the current upgrade proposal
+func resetCurrentUpgrade(ctx sdk.Context) {
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 0)
+	upgradeparams.SetUpgradeConfig(ctx, upgradeparams.UpgradeConfig{})
+}
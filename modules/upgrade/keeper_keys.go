@@ -14,6 +14,9 @@ var (
 	startHeightKey		        = "h/%s"		// h/<height>
 	switchKey			        = "s/%s/%s"		// s/<proposalId>/<switchVoterAddress>
 	DoingSwitchKey				= []byte("d")		// whether system is doing switch
+	upgradeAttemptKey			= "a/%s"		// a/<proposalId>
+	UpgradeAttemptsSubspace		= []byte("a/")		// all the upgrade attempts
+	migratedKey					= "m/%s"		// m/<versionId>
 )
 
 func GetCurrentVersionKey() []byte {
@@ -36,6 +39,14 @@ func GetSwitchKey(proposalID uint64, switchVoterAddr sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf(switchKey, UintToHexString(proposalID), switchVoterAddr.String()))
 }
 
+func GetUpgradeAttemptKey(proposalID uint64) []byte {
+	return []byte(fmt.Sprintf(upgradeAttemptKey, UintToHexString(proposalID)))
+}
+
+func GetMigratedKey(versionID int64) []byte {
+	return []byte(fmt.Sprintf(migratedKey, IntToHexString(versionID)))
+}
+
 func IntToHexString(i int64) string {
 	hex := strconv.FormatInt(i, 16)
 	var stringBuild bytes.Buffer
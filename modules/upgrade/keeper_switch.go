@@ -82,6 +82,16 @@ func buildModuleListBucket(moduleList ModuleLifeTimeList) {
 	Inited = true
 }
 
+// RegisterVersionModuleList registers the whole module list of a version,
+// the modules of the previous versions left out of it are retired
+func RegisterVersionModuleList(verId int64, moduleList ModuleLifeTimeList) {
+	if ModuleListBucket == nil {
+		ModuleListBucket = make(map[int64]ModuleLifeTimeList)
+	}
+	ModuleListBucket[verId] = moduleList
+	Inited = true
+}
+
 func GetModuleListFromBucket(verId int64) (ModuleLifeTimeList, bool) {
 	moduleList, ok := ModuleListBucket[verId]
 	if !ok {
@@ -133,7 +143,9 @@ func (k Keeper) DoSwitchBegin(ctx sdk.Context) {
 	k.SetDoingSwitch(ctx, true)
 }
 
-func (k Keeper) DoSwitchEnd(ctx sdk.Context) {
+// DoSwitchEnd switches the chain to the next version. If a migration of the new version fails the
+// switch is aborted, the failure is recorded in the upgrade history and the chain keeps the current version.
+func (k Keeper) DoSwitchEnd(ctx sdk.Context) sdk.Error {
 	currentVersion := k.GetCurrentVersion(ctx)
 	if currentVersion == nil {
 		panic("No current version info found")
@@ -146,6 +158,16 @@ func (k Keeper) DoSwitchEnd(ctx sdk.Context) {
 
 	VersionToBeSwitched := NewVersion(currentVersion.Id+1, 0, 0, moduleList)
 
+	// transform the stored data for the new version, the new version can't run on the old state
+	if _, err := k.RunMigrations(ctx, VersionToBeSwitched.Id); err != nil {
+		ctx.Logger().With("module", "x/upgrade").Error(err.Error())
+
+		k.AddUpgradeAttempt(ctx, UpgradeAttemptFailed)
+		k.SetDoingSwitch(ctx, false)
+		resetCurrentUpgrade(ctx)
+		return err
+	}
+
 	upgradeparams.CurrentUpgradeProposalIdParameter.LoadValue(ctx)
 	VersionToBeSwitched.ProposalID = upgradeparams.CurrentUpgradeProposalIdParameter.Value
 	VersionToBeSwitched.Start = ctx.BlockHeight()
@@ -153,8 +175,19 @@ func (k Keeper) DoSwitchEnd(ctx sdk.Context) {
 	k.AddNewVersion(ctx, VersionToBeSwitched)
 
 	k.SetDoingSwitch(ctx, false)
-	upgradeparams.SetCurrentUpgradeProposalId(ctx, 0)
+	resetCurrentUpgrade(ctx)
 	k.SetKVStoreKeylist(ctx)
+	return nil
+}
+
+// validProtocolVersion checks the current upgrade proposal switches to the next version, if it declares one
+func (k Keeper) validProtocolVersion(ctx sdk.Context) bool {
+	protocolVersion := upgradeparams.GetUpgradeConfig(ctx).ProtocolVersion
+	if protocolVersion == 0 {
+		return true
+	}
+	currentVersion := k.GetCurrentVersion(ctx)
+	return currentVersion != nil && protocolVersion == currentVersion.Id+1
 }
 
 func (k Keeper) OnlyRunAfterVersionId(ctx sdk.Context, versionId int64) bool {
@@ -0,0 +1,71 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Migration - transforms the stored data of a module when the chain switches to a new version
+type Migration struct {
+	Module  string
+	Name    string
+	Migrate func(ctx sdk.Context) error
+}
+
+// MigrationResult - what a migration did, reported by the dry run
+type MigrationResult struct {
+	Module string `json:"module"`
+	Name   string `json:"name"`
+	Error  string `json:"error,omitempty"`
+}
+
+var MigrationBucket map[int64][]Migration
+
+// RegisterMigrations registers the migrations run at the switch to a version, in the order they run
+func RegisterMigrations(verId int64, migrations []Migration) {
+	if MigrationBucket == nil {
+		MigrationBucket = make(map[int64][]Migration)
+	}
+	MigrationBucket[verId] = migrations
+}
+
+func GetMigrationsFromBucket(verId int64) []Migration {
+	return MigrationBucket[verId]
+}
+
+func (k Keeper) setMigrated(ctx sdk.Context, verId int64) {
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Set(GetMigratedKey(verId), []byte{byte(1)})
+}
+
+// HasMigrated checks whether the migrations of a version have been run
+func (k Keeper) HasMigrated(ctx sdk.Context, verId int64) bool {
+	kvStore := ctx.KVStore(k.storeKey)
+	return kvStore.Has(GetMigratedKey(verId))
+}
+
+// RunMigrations runs the migrations of a version exactly once. The migrations run on a cached
+// context, nothing is written unless all of them succeed.
+func (k Keeper) RunMigrations(ctx sdk.Context, verId int64) ([]MigrationResult, sdk.Error) {
+	if k.HasMigrated(ctx, verId) {
+		return nil, nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	var results []MigrationResult
+	for _, migration := range GetMigrationsFromBucket(verId) {
+		result := MigrationResult{Module: migration.Module, Name: migration.Name}
+		if err := migration.Migrate(cacheCtx); err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			return results, NewError(DefaultCodespace, CodeMigrationFailed,
+				fmt.Sprintf("Migration %s of module %s to version %d failed: %s", migration.Name, migration.Module, verId, err.Error()))
+		}
+		results = append(results, result)
+	}
+	write()
+
+	k.setMigrated(ctx, verId)
+	return results, nil
+}
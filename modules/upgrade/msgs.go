@@ -1,6 +1,9 @@
 package upgrade
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -13,13 +16,15 @@ type MsgSwitch struct {
 	Title		string
 	ProposalID	uint64
 	Voter		sdk.AccAddress
+	SoftwareHash	string // hex sha256 of the software the validator runs
 }
 
-func NewMsgSwitch( title string, proposalID uint64, voter sdk.AccAddress) MsgSwitch {
+func NewMsgSwitch( title string, proposalID uint64, voter sdk.AccAddress, softwareHash string) MsgSwitch {
 	return MsgSwitch{
 		Title:title,
 		ProposalID: proposalID,
 		Voter:      voter,
+		SoftwareHash: softwareHash,
 	}
 }
 
@@ -35,6 +40,11 @@ func (msg MsgSwitch) GetSignBytes() []byte {
 }
 
 func (msg MsgSwitch) ValidateBasic() sdk.Error {
+	if len(msg.SoftwareHash) > 0 {
+		if bz, err := hex.DecodeString(msg.SoftwareHash); err != nil || len(bz) != sha256.Size {
+			return NewError(DefaultCodespace, CodeInvalidSoftwareHash, "Software hash should be a hex sha256")
+		}
+	}
 	return nil
 }
 
@@ -1,6 +1,10 @@
 package upgradeparams
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/params"
 )
@@ -73,7 +77,7 @@ func (param *ProposalAcceptHeightParam) LoadValue(ctx sdk.Context) bool {
 
 var SwitchPeriodParameter SwitchPeriodParam
 
-var _ params.SignalParameter = (*SwitchPeriodParam)(nil)
+var _ params.GovParameter = (*SwitchPeriodParam)(nil)
 
 type SwitchPeriodParam struct {
 	Value      int64
@@ -103,3 +107,190 @@ func (param *SwitchPeriodParam) LoadValue(ctx sdk.Context) bool {
 	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
 	return true
 }
+
+func (param *SwitchPeriodParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *SwitchPeriodParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *SwitchPeriodParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *SwitchPeriodParam) Valid(jsonStr string) sdk.Error {
+	var value int64
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSwitchPeriod, fmt.Sprintf("Json is not valid"))
+	}
+	if value <= 0 {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSwitchPeriod, fmt.Sprintf("Invalid SwitchPeriod [%d] should be positive", value))
+	}
+	return nil
+}
+
+var SwitchThresholdParameter SwitchThresholdParam
+
+var _ params.GovParameter = (*SwitchThresholdParam)(nil)
+
+// SwitchThresholdParam - minimum share of the voting power that has to switch for an upgrade to pass
+type SwitchThresholdParam struct {
+	Value      sdk.Dec
+	paramSpace params.Subspace
+}
+
+func (param *SwitchThresholdParam) InitGenesis(genesisState interface{}) {
+	if value, ok := genesisState.(sdk.Dec); ok && !value.IsNil() {
+		param.Value = value
+	} else {
+		param.Value = sdk.NewDecWithPrec(95, 2)
+	}
+}
+
+func (param *SwitchThresholdParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *SwitchThresholdParam) GetStoreKey() []byte {
+	return []byte("upgradeSwitchThreshold")
+}
+
+func (param *SwitchThresholdParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *SwitchThresholdParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+func (param *SwitchThresholdParam) ToJson(jsonStr string) string {
+	var jsonBytes []byte
+
+	if len(jsonStr) == 0 {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		jsonBytes, _ = json.Marshal(param.Value)
+		return string(jsonBytes)
+	}
+	return string(jsonBytes)
+}
+
+func (param *SwitchThresholdParam) Update(ctx sdk.Context, jsonStr string) {
+	if err := json.Unmarshal([]byte(jsonStr), &param.Value); err == nil {
+		param.SaveValue(ctx)
+	}
+}
+
+func (param *SwitchThresholdParam) GetValueFromRawData(cdc *codec.Codec, res []byte) interface{} {
+	cdc.UnmarshalJSON(res, &param.Value)
+	return param.Value
+}
+
+func (param *SwitchThresholdParam) Valid(jsonStr string) sdk.Error {
+	var value sdk.Dec
+	if err := json.Unmarshal([]byte(jsonStr), &value); err != nil {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSwitchThreshold, fmt.Sprintf("Json is not valid"))
+	}
+	if value.LTE(sdk.NewDecWithPrec(5, 1)) || value.GT(sdk.OneDec()) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSwitchThreshold, fmt.Sprintf("Invalid SwitchThreshold [%s] should be larger than 0.5 and no more than 1", value.String()))
+	}
+	return nil
+}
+
+var AbortUpgradeProposalIdParameter AbortUpgradeProposalIdParam
+
+var _ params.SignalParameter = (*AbortUpgradeProposalIdParam)(nil)
+
+// AbortUpgradeProposalIdParam - the upgrade proposal an abort proposal cancels, 0 if there is none
+type AbortUpgradeProposalIdParam struct {
+	Value      uint64
+	paramSpace params.Subspace
+}
+
+func (param *AbortUpgradeProposalIdParam) InitGenesis(genesisState interface{}) {
+	param.Value = 0
+}
+
+func (param *AbortUpgradeProposalIdParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *AbortUpgradeProposalIdParam) GetStoreKey() []byte {
+	return []byte("upgradeAbortProposalId")
+}
+
+func (param *AbortUpgradeProposalIdParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *AbortUpgradeProposalIdParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
+
+var UpgradeConfigParameter UpgradeConfigParam
+
+var _ params.SignalParameter = (*UpgradeConfigParam)(nil)
+
+// UpgradeConfig - the software the current upgrade proposal switches to
+type UpgradeConfig struct {
+	ProtocolVersion int64  `json:"protocol_version"` // version id of the new software, 0 for the next version
+	Software        string `json:"software"`         // where to download the new software
+	SwitchHeight    int64  `json:"switch_height"`    // height the switch is tallied at, 0 for the accept height plus the switch period
+	Checksum        string `json:"checksum"`         // hex sha256 of the new software binary, any software is accepted if empty
+}
+
+type UpgradeConfigParam struct {
+	Value      UpgradeConfig
+	paramSpace params.Subspace
+}
+
+func (param *UpgradeConfigParam) InitGenesis(genesisState interface{}) {
+	param.Value = UpgradeConfig{}
+}
+
+func (param *UpgradeConfigParam) SetReadWriter(paramSpace params.Subspace) {
+	param.paramSpace = paramSpace
+}
+
+func (param *UpgradeConfigParam) GetStoreKey() []byte {
+	return []byte("upgradeConfig")
+}
+
+func (param *UpgradeConfigParam) SaveValue(ctx sdk.Context) {
+	param.paramSpace.Set(ctx, param.GetStoreKey(), param.Value)
+}
+
+func (param *UpgradeConfigParam) LoadValue(ctx sdk.Context) bool {
+	if param.paramSpace.Has(ctx, param.GetStoreKey()) == false {
+		return false
+	}
+	param.paramSpace.Get(ctx, param.GetStoreKey(), &param.Value)
+	return true
+}
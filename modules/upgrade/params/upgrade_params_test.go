@@ -169,3 +169,41 @@ func TestUpgradeParameterSetAndGet(t *testing.T) {
 	SetSwitchPeriod(ctx,30000)
 	require.Equal(t,int64(30000),GetSwitchPeriod(ctx))
 }
+
+func TestSwitchThresholdParameter(t *testing.T) {
+	skey := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	ctx := defaultContext(skey, tkeyParams)
+	cdc := codec.New()
+
+	paramKeeper := params.NewKeeper(
+		cdc,
+		skey, tkeyParams,
+	)
+
+	subspace := paramKeeper.Subspace("Gov").WithTypeTable(params.NewTypeTable(
+		SwitchPeriodParameter.GetStoreKey(), int64(0),
+		SwitchThresholdParameter.GetStoreKey(), sdk.Dec{},
+	))
+
+	SwitchThresholdParameter.SetReadWriter(subspace)
+	find := SwitchThresholdParameter.LoadValue(ctx)
+	require.Equal(t, find, false)
+
+	SwitchThresholdParameter.InitGenesis(nil)
+	require.Equal(t, sdk.NewDecWithPrec(95, 2), SwitchThresholdParameter.Value)
+
+	SwitchThresholdParameter.Value = sdk.NewDecWithPrec(8, 1)
+	SwitchThresholdParameter.SaveValue(ctx)
+
+	SwitchThresholdParameter.LoadValue(ctx)
+	require.True(t, sdk.NewDecWithPrec(8, 1).Equal(SwitchThresholdParameter.Value))
+
+	require.Nil(t, SwitchThresholdParameter.Valid(`"0.9"`))
+	require.NotNil(t, SwitchThresholdParameter.Valid(`"0.5"`))
+	require.NotNil(t, SwitchThresholdParameter.Valid(`"1.1"`))
+
+	require.Nil(t, SwitchPeriodParameter.Valid(`100`))
+	require.NotNil(t, SwitchPeriodParameter.Valid(`0`))
+}
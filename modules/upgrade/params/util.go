@@ -34,3 +34,45 @@ func SetSwitchPeriod(ctx sdk.Context,i int64 ){
 	SwitchPeriodParameter.Value = i
 	SwitchPeriodParameter.SaveValue(ctx)
 }
+
+func GetSwitchThreshold(ctx sdk.Context) sdk.Dec {
+	SwitchThresholdParameter.LoadValue(ctx)
+	return SwitchThresholdParameter.Value
+}
+
+func SetSwitchThreshold(ctx sdk.Context, threshold sdk.Dec) {
+	SwitchThresholdParameter.Value = threshold
+	SwitchThresholdParameter.SaveValue(ctx)
+}
+
+func GetAbortUpgradeProposalId(ctx sdk.Context) uint64 {
+	if !AbortUpgradeProposalIdParameter.LoadValue(ctx) {
+		return 0
+	}
+	return AbortUpgradeProposalIdParameter.Value
+}
+
+func SetAbortUpgradeProposalId(ctx sdk.Context, i uint64) {
+	AbortUpgradeProposalIdParameter.Value = i
+	AbortUpgradeProposalIdParameter.SaveValue(ctx)
+}
+
+func GetUpgradeConfig(ctx sdk.Context) UpgradeConfig {
+	if !UpgradeConfigParameter.LoadValue(ctx) {
+		return UpgradeConfig{}
+	}
+	return UpgradeConfigParameter.Value
+}
+
+func SetUpgradeConfig(ctx sdk.Context, config UpgradeConfig) {
+	UpgradeConfigParameter.Value = config
+	UpgradeConfigParameter.SaveValue(ctx)
+}
+
+// GetSwitchHeight returns the height the switch of the current upgrade proposal is tallied at
+func GetSwitchHeight(ctx sdk.Context) int64 {
+	if switchHeight := GetUpgradeConfig(ctx).SwitchHeight; switchHeight > 0 {
+		return switchHeight
+	}
+	return GetProposalAcceptHeight(ctx) + GetSwitchPeriod(ctx)
+}
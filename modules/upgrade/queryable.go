@@ -0,0 +1,48 @@
+package upgrade
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryReadiness = "readiness"
+	QueryHistory   = "history"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryReadiness:
+			return queryReadiness(ctx, path[1:], req, keeper)
+		case QueryHistory:
+			return queryHistory(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+// nolint: unparam
+func queryReadiness(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	readiness := keeper.GetReadiness(ctx)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, readiness)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	attempts := keeper.GetUpgradeAttempts(ctx)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, attempts)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
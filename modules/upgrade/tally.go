@@ -1,32 +1,68 @@
 package upgrade
 
 import (
-	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/upgrade/params"
+	sdk "github.com/irisnet/irishub/types"
 )
 
-var Threshold = sdk.NewDecWithPrec(95, 2)
+// ValidatorReadiness - whether a validator has switched to the software of the current upgrade proposal
+type ValidatorReadiness struct {
+	Operator     sdk.ValAddress `json:"operator"`
+	Power        sdk.Dec        `json:"power"`
+	Switched     bool           `json:"switched"`
+	SoftwareHash string         `json:"software_hash"` // attested by the switch msg
+}
 
-func tally(ctx sdk.Context, k Keeper) (passes bool) {
+// Readiness - the switches on the current upgrade proposal, Passes projects the tally at the switch height
+type Readiness struct {
+	ProposalID    uint64                      `json:"proposal_id"`
+	Config        upgradeparams.UpgradeConfig `json:"config"`
+	SwitchHeight  int64                       `json:"switch_height"`
+	Threshold     sdk.Dec                     `json:"threshold"`
+	TotalPower    sdk.Dec                     `json:"total_power"`
+	SwitchedPower sdk.Dec                     `json:"switched_power"`
+	Passes        bool                        `json:"passes"`
+	Validators    []ValidatorReadiness        `json:"validators"`
+}
 
+// GetReadiness tallies the switches of the validators on the current upgrade proposal
+func (k Keeper) GetReadiness(ctx sdk.Context) Readiness {
 	proposalID := upgradeparams.GetCurrentUpgradeProposalId(ctx)
+	readiness := Readiness{
+		ProposalID:    proposalID,
+		Threshold:     upgradeparams.GetSwitchThreshold(ctx),
+		TotalPower:    sdk.ZeroDec(),
+		SwitchedPower: sdk.ZeroDec(),
+		Validators:    []ValidatorReadiness{},
+	}
+	if proposalID == 0 {
+		return readiness
+	}
+	readiness.Config = upgradeparams.GetUpgradeConfig(ctx)
+	readiness.SwitchHeight = upgradeparams.GetSwitchHeight(ctx)
 
-	if proposalID != 0 {
-
-		totalVotingPower := sdk.ZeroDec()
-		switchVotingPower := sdk.ZeroDec()
-		for _, validator := range k.sk.GetAllValidators(ctx) {
-			totalVotingPower = totalVotingPower.Add(validator.GetPower())
+	for _, validator := range k.sk.GetAllValidators(ctx) {
+		readiness.TotalPower = readiness.TotalPower.Add(validator.GetPower())
 
-			valAcc := sdk.AccAddress(validator.OperatorAddr)
-			if _, ok := k.GetSwitch(ctx, proposalID, valAcc); ok {
-				switchVotingPower = switchVotingPower.Add(validator.GetPower())
-			}
+		validatorReadiness := ValidatorReadiness{
+			Operator: validator.OperatorAddr,
+			Power:    validator.GetPower(),
 		}
-		// If more than 95% of validator update , do switch
-		if switchVotingPower.Quo(totalVotingPower).GT(Threshold) {
-			return true
+		valAcc := sdk.AccAddress(validator.OperatorAddr)
+		if msgSwitch, ok := k.GetSwitch(ctx, proposalID, valAcc); ok {
+			readiness.SwitchedPower = readiness.SwitchedPower.Add(validator.GetPower())
+			validatorReadiness.Switched = true
+			validatorReadiness.SoftwareHash = msgSwitch.SoftwareHash
 		}
+		readiness.Validators = append(readiness.Validators, validatorReadiness)
+	}
+	// If more than the threshold of validator update , do switch
+	if readiness.TotalPower.GT(sdk.ZeroDec()) && readiness.SwitchedPower.Quo(readiness.TotalPower).GT(readiness.Threshold) {
+		readiness.Passes = true
 	}
-	return false
+	return readiness
+}
+
+func tally(ctx sdk.Context, k Keeper) (passes bool) {
+	return k.GetReadiness(ctx).Passes
 }
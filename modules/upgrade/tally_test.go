@@ -0,0 +1,141 @@
+package upgrade
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/irisnet/irishub/modules/params"
+	"github.com/irisnet/irishub/modules/stake"
+	"github.com/irisnet/irishub/modules/upgrade/params"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setUpgradeParams(ctx sdk.Context, paramKeeper params.Keeper) {
+	subspace := paramKeeper.Subspace("Sig").WithTypeTable(params.NewTypeTable(
+		upgradeparams.CurrentUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
+		upgradeparams.ProposalAcceptHeightParameter.GetStoreKey(), int64(0),
+		upgradeparams.SwitchPeriodParameter.GetStoreKey(), int64(0),
+		upgradeparams.UpgradeConfigParameter.GetStoreKey(), upgradeparams.UpgradeConfig{},
+		upgradeparams.SwitchThresholdParameter.GetStoreKey(), sdk.Dec{},
+		upgradeparams.AbortUpgradeProposalIdParameter.GetStoreKey(), uint64((0)),
+	))
+
+	upgradeparams.ProposalAcceptHeightParameter.SetReadWriter(subspace)
+	upgradeparams.CurrentUpgradeProposalIdParameter.SetReadWriter(subspace)
+	upgradeparams.SwitchPeriodParameter.SetReadWriter(subspace)
+	upgradeparams.UpgradeConfigParameter.SetReadWriter(subspace)
+	upgradeparams.SwitchThresholdParameter.SetReadWriter(subspace)
+	upgradeparams.AbortUpgradeProposalIdParameter.SetReadWriter(subspace)
+}
+
+// sets a bonded validator with the voting power given in iris
+func setBondedValidator(ctx sdk.Context, keeper Keeper, i int, power int64) {
+	validator := stake.NewValidator(sdk.ValAddress(addrs[i]), pks[i], stake.Description{})
+	validator.Status = sdk.Bonded
+	validator.Tokens = sdk.NewDecFromInt(sdk.NewIntWithDecimal(power, 18))
+	keeper.sk.SetValidator(ctx, validator)
+}
+
+func softwareHash(software string) string {
+	hash := sha256.Sum256([]byte(software))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestKeeper_GetReadiness(t *testing.T) {
+	ctx, keeper, paramKeeper := createTestInput(t)
+	setUpgradeParams(ctx, paramKeeper)
+	upgradeparams.SetSwitchThreshold(ctx, sdk.NewDecWithPrec(5, 1))
+
+	setBondedValidator(ctx, keeper, 0, 10)
+	setBondedValidator(ctx, keeper, 1, 20)
+	setBondedValidator(ctx, keeper, 2, 30)
+
+	// no upgrade proposal in process
+	readiness := keeper.GetReadiness(ctx)
+	require.Equal(t, uint64(0), readiness.ProposalID)
+	require.False(t, readiness.Passes)
+	require.Equal(t, 0, len(readiness.Validators))
+
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 1)
+	upgradeparams.SetUpgradeConfig(ctx, upgradeparams.UpgradeConfig{SwitchHeight: 100})
+
+	// exactly the threshold of the voting power is not enough
+	keeper.SetSwitch(ctx, 1, addrs[2], NewMsgSwitch("Upgrade", 1, addrs[2], ""))
+	readiness = keeper.GetReadiness(ctx)
+	require.Equal(t, int64(100), readiness.SwitchHeight)
+	require.True(t, readiness.TotalPower.Equal(sdk.NewDec(60)))
+	require.True(t, readiness.SwitchedPower.Equal(sdk.NewDec(30)))
+	require.False(t, readiness.Passes)
+	require.False(t, tally(ctx, keeper))
+
+	// more than the threshold passes
+	keeper.SetSwitch(ctx, 1, addrs[0], NewMsgSwitch("Upgrade", 1, addrs[0], softwareHash("iris")))
+	readiness = keeper.GetReadiness(ctx)
+	require.True(t, readiness.SwitchedPower.Equal(sdk.NewDec(40)))
+	require.True(t, readiness.Passes)
+	require.True(t, tally(ctx, keeper))
+
+	switched := 0
+	for _, validator := range readiness.Validators {
+		if validator.Switched {
+			switched++
+		}
+		if validator.Operator.Equals(sdk.ValAddress(addrs[0])) {
+			require.Equal(t, softwareHash("iris"), validator.SoftwareHash)
+		}
+	}
+	require.Equal(t, 2, switched)
+
+	// the switches of another proposal don't count
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 2)
+	readiness = keeper.GetReadiness(ctx)
+	require.True(t, readiness.SwitchedPower.IsZero())
+	require.False(t, readiness.Passes)
+}
+
+func TestHandler_SwitchSoftwareHash(t *testing.T) {
+	ctx, keeper, paramKeeper := createTestInput(t)
+	setUpgradeParams(ctx, paramKeeper)
+	setBondedValidator(ctx, keeper, 0, 10)
+	setBondedValidator(ctx, keeper, 1, 10)
+
+	handler := NewHandler(keeper)
+	upgradeparams.SetCurrentUpgradeProposalId(ctx, 1)
+	upgradeparams.SetUpgradeConfig(ctx, upgradeparams.UpgradeConfig{Checksum: softwareHash("iris")})
+
+	// the software hash has to match the checksum of the proposal
+	res := handler(ctx, NewMsgSwitch("Upgrade", 1, addrs[0], softwareHash("other")))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSoftwareMismatch), res.Code)
+	_, found := keeper.GetSwitch(ctx, 1, addrs[0])
+	require.False(t, found)
+
+	res = handler(ctx, NewMsgSwitch("Upgrade", 1, addrs[0], ""))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSoftwareMismatch), res.Code)
+
+	// the checksum is compared case insensitively
+	res = handler(ctx, NewMsgSwitch("Upgrade", 1, addrs[0], strings.ToUpper(softwareHash("iris"))))
+	require.True(t, res.IsOK(), res.Log)
+	_, found = keeper.GetSwitch(ctx, 1, addrs[0])
+	require.True(t, found)
+
+	res = handler(ctx, NewMsgSwitch("Upgrade", 1, addrs[0], softwareHash("iris")))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeDoubleSwitch), res.Code)
+
+	// only a validator switches, and only on the current proposal
+	res = handler(ctx, NewMsgSwitch("Upgrade", 1, addrs[2], softwareHash("iris")))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNotValidator), res.Code)
+	res = handler(ctx, NewMsgSwitch("Upgrade", 2, addrs[1], softwareHash("iris")))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNotCurrentProposal), res.Code)
+
+	// any software is accepted by a proposal without a checksum
+	upgradeparams.SetUpgradeConfig(ctx, upgradeparams.UpgradeConfig{})
+	res = handler(ctx, NewMsgSwitch("Upgrade", 1, addrs[1], softwareHash("other")))
+	require.True(t, res.IsOK(), res.Log)
+
+	// a malformed hash is rejected before reaching the handler
+	require.NotNil(t, NewMsgSwitch("Upgrade", 1, addrs[1], "iris").ValidateBasic())
+	require.Nil(t, NewMsgSwitch("Upgrade", 1, addrs[1], softwareHash("iris")).ValidateBasic())
+}
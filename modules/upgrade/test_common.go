@@ -56,6 +56,7 @@ func createTestCodec() *codec.Codec {
 
 func createTestInput(t *testing.T) (sdk.Context, Keeper, params.Keeper) {
 	keyAcc := sdk.NewKVStoreKey("acc")
+	keyBank := sdk.NewKVStoreKey("bank")
 	keyStake := sdk.NewKVStoreKey("stake")
 	keyUpdate := sdk.NewKVStoreKey("update")
 	keyParams := sdk.NewKVStoreKey("params")
@@ -66,6 +67,7 @@ func createTestInput(t *testing.T) (sdk.Context, Keeper, params.Keeper) {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStake, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyUpdate, sdk.StoreTypeIAVL, db)
     ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
@@ -78,7 +80,7 @@ func createTestInput(t *testing.T) (sdk.Context, Keeper, params.Keeper) {
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewTMLogger(os.Stdout))
 	cdc := createTestCodec()
 	AccountKeeper := auth.NewAccountKeeper(cdc, keyAcc, auth.ProtoBaseAccount)
-	ck := bank.NewBaseKeeper(AccountKeeper)
+	ck := bank.NewBaseKeeper(cdc, keyBank, AccountKeeper)
 
 	paramsKeeper := params.NewKeeper(
 		cdc,
@@ -2,7 +2,9 @@ package upgrade
 
 import (
     sdk "github.com/irisnet/irishub/types"
+    "github.com/irisnet/irishub/modules/upgrade/params"
     "math"
+    "strings"
 )
 
 type ModuleLifeTime struct {
@@ -51,8 +53,9 @@ func (v Version) getMsgType(msg sdk.Msg) (string, sdk.Error) {
     msgType := msg.Route()
 
     for _, module := range v.ModuleList {
-        if msgType == module.Handler {
-            return msgType, nil
+        // the handlers of the later versions are named <route>-<version id>
+        if msgType == strings.Split(module.Handler, "-")[0] {
+            return module.Handler, nil
         }
     }
 
@@ -67,4 +70,32 @@ func NewVersionList() VersionList {
 
 func (m VersionList) AddVersion(v Version) {
 	m = append(m,v)
-}
\ No newline at end of file
+}
+// UpgradeAttemptStatus - how an upgrade proposal ended its switch period
+type UpgradeAttemptStatus string
+
+const (
+    UpgradeAttemptPassed  UpgradeAttemptStatus = "Passed"  // enough validators switched, the network switches to the new version
+    UpgradeAttemptDropped UpgradeAttemptStatus = "Dropped" // not enough validators switched before the switch height
+    UpgradeAttemptAborted UpgradeAttemptStatus = "Aborted" // cancelled by an abort proposal during the switch period
+    UpgradeAttemptFailed  UpgradeAttemptStatus = "Failed"  // a migration of the new version failed, the network keeps the current version
+)
+
+// UpgradeAttempt - the outcome of an accepted upgrade proposal
+type UpgradeAttempt struct {
+    ProposalID    uint64                      `json:"proposal_id"`
+    Status        UpgradeAttemptStatus        `json:"status"`
+    Height        int64                       `json:"height"`         // height the switch period ended at
+    Config        upgradeparams.UpgradeConfig `json:"config"`
+    SwitchedPower sdk.Dec                     `json:"switched_power"` // share of the voting power that switched
+}
+
+func NewUpgradeAttempt(proposalID uint64, status UpgradeAttemptStatus, height int64, config upgradeparams.UpgradeConfig, switchedPower sdk.Dec) UpgradeAttempt {
+    return UpgradeAttempt{
+        ProposalID:    proposalID,
+        Status:        status,
+        Height:        height,
+        Config:        config,
+        SwitchedPower: switchedPower,
+    }
+}
@@ -20,6 +20,10 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64) (json.RawMessage, []tmtypes.GenesisValidator, error)
+
+	// AppMigrator is a function that runs the migrations of a version against
+	// an exported state and returns a JSON-serializable report of the changes.
+	AppMigrator func(log.Logger, tmtypes.GenesisDoc, int64) (interface{}, error)
 )
 
 func openDB(rootDir string) (dbm.DB, error) {
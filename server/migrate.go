@@ -0,0 +1,53 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagProtocolVersion = "protocol-version"
+)
+
+// MigrateDryRunCmd runs the state migrations of a version against an exported state
+// and prints the changes, the node's own state is left untouched.
+func MigrateDryRunCmd(ctx *Context, appMigrator AppMigrator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-dry-run [exported-genesis-file]",
+		Short: "Run the state migrations of a version against an exported state and report the changes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			doc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			version := viper.GetInt64(flagProtocolVersion)
+			if version <= 0 {
+				return errors.Errorf("invalid protocol version %d", version)
+			}
+
+			result, err := appMigrator(ctx.Logger, *doc, version)
+			if err != nil {
+				return errors.Errorf("error running the migrations: %v\n", err)
+			}
+
+			// the report carries raw JSON of the module states, it is encoded as is
+			encoded, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(encoded))
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagProtocolVersion, 0, "The version whose migrations are run")
+	cmd.MarkFlagRequired(flagProtocolVersion)
+	return cmd
+}
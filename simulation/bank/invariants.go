@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/auth"
+	"github.com/irisnet/irishub/modules/bank"
 	"github.com/irisnet/irishub/simulation/mock"
 	"github.com/irisnet/irishub/simulation/mock/simulation"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -50,3 +51,24 @@ func TotalCoinsInvariant(mapper auth.AccountKeeper, totalSupplyFn func() sdk.Coi
 		return nil
 	}
 }
+
+// SupplyInvariant checks that the sum of the coins across all accounts and the
+// coins held by the modules equals the supply kept by the bank
+func SupplyInvariant(mapper auth.AccountKeeper, keeper bank.Keeper, moduleHoldingsFn func(ctx sdk.Context) sdk.Coins) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(false, abci.Header{})
+		totalCoins := moduleHoldingsFn(ctx)
+
+		chkAccount := func(acc auth.Account) bool {
+			totalCoins = totalCoins.Plus(acc.GetCoins())
+			return false
+		}
+
+		mapper.IterateAccounts(ctx, chkAccount)
+		supply := keeper.GetTotalSupply(ctx)
+		if !supply.IsEqual(totalCoins) {
+			return fmt.Errorf("supply %s doesn't equal the coins of the accounts and the modules %s", supply, totalCoins)
+		}
+		return nil
+	}
+}
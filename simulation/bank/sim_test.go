@@ -18,7 +18,7 @@ func TestBankWithRandomMessages(t *testing.T) {
 	mapper := mapp.AccountKeeper
 	bankKeeper := mapp.BankKeeper
 
-	mapp.Router().AddRoute("bank", []*sdk.KVStoreKey{mapp.KeyAccount}, bank.NewHandler(bankKeeper))
+	mapp.Router().AddRoute("bank", []*sdk.KVStoreKey{mapp.KeyAccount, mapp.KeyBank}, bank.NewHandler(bankKeeper))
 
 	err := mapp.CompleteSetup()
 	if err != nil {
@@ -39,6 +39,7 @@ func TestBankWithRandomMessages(t *testing.T) {
 		[]simulation.Invariant{
 			NonnegativeBalanceInvariant(mapper),
 			TotalCoinsInvariant(mapper, func() sdk.Coins { return mapp.TotalCoinsSupply }),
+			SupplyInvariant(mapper, bankKeeper, mapp.FeeCollectionKeeper.GetCollectedFees),
 		},
 		30, 60,
 		false,
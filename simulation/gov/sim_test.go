@@ -9,6 +9,8 @@ import (
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/irisnet/irishub/modules/bank"
+	"github.com/irisnet/irishub/modules/distribution"
+	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/modules/stake"
 	"github.com/irisnet/irishub/modules/gov"
 	"github.com/irisnet/irishub/simulation/mock"
@@ -27,6 +29,8 @@ func TestGovWithRandomMessages(t *testing.T) {
 	stakeTKey := mapp.TkeyStake
 	paramKey := mapp.KeyParams
 	govKey := sdk.NewKVStoreKey("gov")
+	distrKey := sdk.NewKVStoreKey("distr")
+	guardianKey := sdk.NewKVStoreKey("guardian")
 
 	paramKeeper := mapp.ParamsKeeper
 	stakeKeeper := stake.NewKeeper(
@@ -35,20 +39,27 @@ func TestGovWithRandomMessages(t *testing.T) {
 		paramKeeper.Subspace(stake.DefaultParamspace),
 		stake.DefaultCodespace,
 	)
+	distrKeeper := distribution.NewKeeper(
+		mapp.Cdc, distrKey,
+		paramKeeper.Subspace(distribution.DefaultParamspace),
+		bankKeeper, stakeKeeper, mapp.FeeCollectionKeeper,
+		distribution.DefaultCodespace,
+	)
+	guardianKeeper := guardian.NewKeeper(mapp.Cdc, guardianKey, guardian.DefaultCodespace)
 	govKeeper := gov.NewKeeper(
 		mapp.Cdc,
 		govKey,
-		bankKeeper, stakeKeeper,
+		bankKeeper, distrKeeper, guardianKeeper, stakeKeeper,
 		mapp.RegisterCodespace(gov.DefaultCodespace),
 	)
 
-	mapp.Router().AddRoute("gov", []*sdk.KVStoreKey{govKey, mapp.KeyAccount, stakeKey, paramKey}, gov.NewHandler(govKeeper))
+	mapp.Router().AddRoute("gov", []*sdk.KVStoreKey{govKey, mapp.KeyAccount, stakeKey, paramKey, distrKey, guardianKey}, gov.NewHandler(govKeeper))
 	mapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 		gov.EndBlocker(ctx, govKeeper)
 		return abci.ResponseEndBlock{}
 	})
 
-	err := mapp.CompleteSetup(govKey)
+	err := mapp.CompleteSetup(govKey, distrKey, guardianKey)
 	if err != nil {
 		panic(err)
 	}
@@ -21,6 +21,7 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/irisnet/irishub/modules/arbitration/params"
+	"github.com/irisnet/irishub/modules/asset/params"
 )
 
 const (
@@ -57,6 +58,7 @@ type App struct {
 	Cdc              *codec.Codec // Cdc is public since the codec is passed into the module anyways
 	KeyMain          *sdk.KVStoreKey
 	KeyAccount       *sdk.KVStoreKey
+	KeyBank          *sdk.KVStoreKey
 	KeyFeeCollection *sdk.KVStoreKey
 	KeyStake         *sdk.KVStoreKey
 	TkeyStake        *sdk.TransientStoreKey
@@ -102,6 +104,7 @@ func NewApp() *App {
 		Cdc:              cdc,
 		KeyMain:          sdk.NewKVStoreKey("main"),
 		KeyAccount:       sdk.NewKVStoreKey("acc"),
+		KeyBank:          sdk.NewKVStoreKey("bank"),
 		KeyFeeCollection: sdk.NewKVStoreKey("fee"),
 		KeyStake:         sdk.NewKVStoreKey("stake"),
 		TkeyStake:        sdk.NewTransientStoreKey("transient_stake"),
@@ -118,7 +121,7 @@ func NewApp() *App {
 		auth.ProtoBaseAccount,
 	)
 
-	app.BankKeeper = bank.NewBaseKeeper(app.AccountKeeper)
+	app.BankKeeper = bank.NewBaseKeeper(app.Cdc, app.KeyBank, app.AccountKeeper)
 	app.FeeCollectionKeeper = auth.NewFeeCollectionKeeper(app.Cdc, app.KeyFeeCollection)
 
 	app.ParamsKeeper = params.NewKeeper(
@@ -138,23 +141,37 @@ func NewApp() *App {
 			govparams.DepositProcedureParameter.GetStoreKey(), govparams.DepositProcedure{},
 			govparams.VotingProcedureParameter.GetStoreKey(), govparams.VotingProcedure{},
 			govparams.TallyingProcedureParameter.GetStoreKey(), govparams.TallyingProcedure{},
+			govparams.ProposalProceduresParameter.GetStoreKey(), govparams.ProposalProcedures{},
+			govparams.DepositPolicyParameter.GetStoreKey(), govparams.DepositPolicy{},
 			serviceparams.MaxRequestTimeoutParameter.GetStoreKey(), int64(0),
 			serviceparams.MinDepositMultipleParameter.GetStoreKey(), int64(0),
+			serviceparams.SlashFractionParameter.GetStoreKey(), sdk.Dec{},
 			arbitrationparams.ComplaintRetrospectParameter.GetStoreKey(), []byte{},
 			arbitrationparams.ArbitrationTimelimitParameter.GetStoreKey(), []byte{},
+			assetparams.IssueFeeParameter.GetStoreKey(), sdk.Coin{},
+			assetparams.MintFeeParameter.GetStoreKey(), sdk.Coin{},
+			bam.FeeExchangeRatesParameter.GetStoreKey(), bam.FeeExchangeRates{},
 		)),
 		&govparams.DepositProcedureParameter,
 		&govparams.VotingProcedureParameter,
 		&govparams.TallyingProcedureParameter,
+		&govparams.ProposalProceduresParameter,
+		&govparams.DepositPolicyParameter,
 		&serviceparams.MaxRequestTimeoutParameter,
 		&serviceparams.MinDepositMultipleParameter,
+		&serviceparams.SlashFractionParameter,
 		&arbitrationparams.ComplaintRetrospectParameter,
-		&arbitrationparams.ArbitrationTimelimitParameter)
+		&arbitrationparams.ArbitrationTimelimitParameter,
+		&assetparams.IssueFeeParameter,
+		&assetparams.MintFeeParameter,
+		&bam.FeeExchangeRatesParameter)
 
 	params.RegisterGovParamMapping(
 		&govparams.DepositProcedureParameter,
 		&govparams.VotingProcedureParameter,
-		&govparams.TallyingProcedureParameter)
+		&govparams.TallyingProcedureParameter,
+		&govparams.ProposalProceduresParameter,
+		&govparams.DepositPolicyParameter)
 
 	return app
 }
@@ -164,6 +181,7 @@ func NewApp() *App {
 func (app *App) CompleteSetup(newKeys ...sdk.StoreKey) error {
 	newKeys = append(newKeys, app.KeyMain)
 	newKeys = append(newKeys, app.KeyAccount)
+	newKeys = append(newKeys, app.KeyBank)
 	newKeys = append(newKeys, app.KeyParams)
 	newKeys = append(newKeys, app.KeyStake)
 	newKeys = append(newKeys, app.KeyFeeCollection)
@@ -188,12 +206,15 @@ func (app *App) CompleteSetup(newKeys ...sdk.StoreKey) error {
 
 // InitChainer performs custom logic for initialization.
 func (app *App) InitChainer(ctx sdk.Context, _ abci.RequestInitChain) abci.ResponseInitChain {
-	// Load the genesis accounts
+	// Load the genesis accounts, they hold the whole supply
+	supply := sdk.Coins{}
 	for _, genacc := range app.GenesisAccounts {
 		acc := app.AccountKeeper.NewAccountWithAddress(ctx, genacc.GetAddress())
 		acc.SetCoins(genacc.GetCoins())
 		app.AccountKeeper.SetAccount(ctx, acc)
+		supply = supply.Plus(genacc.GetCoins())
 	}
+	app.BankKeeper.InitSupply(ctx, supply)
 
 	return abci.ResponseInitChain{}
 }